@@ -10,22 +10,45 @@ import (
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/platonso/avito-pr-service/internal/config"
 	"github.com/platonso/avito-pr-service/internal/db"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/metrics"
 	"github.com/platonso/avito-pr-service/internal/repository/postgres"
+	"github.com/platonso/avito-pr-service/internal/service/auth"
+	"github.com/platonso/avito-pr-service/internal/service/idempotency"
 	"github.com/platonso/avito-pr-service/internal/service/pr"
 	"github.com/platonso/avito-pr-service/internal/service/stats"
 	"github.com/platonso/avito-pr-service/internal/service/team"
 	"github.com/platonso/avito-pr-service/internal/service/user"
 	"github.com/platonso/avito-pr-service/internal/transport/handlers"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"log/slog"
 	"net/http"
+	"sync/atomic"
 	"time"
 )
 
+var (
+	errShuttingDown        = errors.New("server is shutting down")
+	errDatabaseUnavailable = errors.New("database unavailable")
+)
+
+// Period of deleting expired idempotency keys
+const idempotencyCleanupInterval = time.Hour
+
 type App struct {
 	cfg    *config.Config
 	l      *slog.Logger
 	dbPool *pgxpool.Pool
+	sqlDB  *sql.DB
 	server *http.Server
+
+	// Version of the latest embedded migration, readiness compares database schema with it
+	schemaVersion int64
+
+	idempotencyService *idempotency.Service
+
+	shuttingDown atomic.Bool
 }
 
 func New(ctx context.Context, cfg *config.Config, l *slog.Logger) (*App, error) {
@@ -42,7 +65,22 @@ func New(ctx context.Context, cfg *config.Config, l *slog.Logger) (*App, error)
 		return nil, err
 	}
 
-	router := a.setupRoutes()
+	if err := auth.ValidateBootstrapKey(a.cfg.BootstrapAPIKey); err != nil {
+		return nil, fmt.Errorf("invalid BOOTSTRAP_API_KEY: %w", err)
+	}
+
+	tokens, err := auth.NewTokenVerifier(auth.TokenConfig{
+		HMACSecret: a.cfg.JWT.HMACSecret,
+		JWKSFile:   a.cfg.JWT.JWKSFile,
+		Issuer:     a.cfg.JWT.Issuer,
+		Audience:   a.cfg.JWT.Audience,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure JWT authentication: %w", err)
+	}
+
+	router := a.setupRoutes(tokens)
+	go a.cleanupIdempotencyKeys(ctx)
 	a.server = &http.Server{
 		Addr:         ":" + a.cfg.HTTPPort,
 		Handler:      router,
@@ -60,54 +98,148 @@ func (a *App) initDB(ctx context.Context) error {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
 	a.dbPool = dbPool
+	a.sqlDB = sql.OpenDB(stdlib.GetConnector(*dbPool.Config().ConnConfig))
 	return nil
 }
 
 func (a *App) migrateDB() error {
-	if err := db.Migrate(sql.OpenDB(stdlib.GetConnector(*a.dbPool.Config().ConnConfig))); err != nil {
+	if err := db.Migrate(a.sqlDB); err != nil {
 		return fmt.Errorf("failed to run migrations: %w", err)
 	}
+
+	version, err := db.LatestVersion()
+	if err != nil {
+		return err
+	}
+	a.schemaVersion = version
+	return nil
+}
+
+// Service is ready when it is not shutting down, Postgres responds and schema is up to date.
+// Probe is not authenticated, so database errors are only logged
+func (a *App) checkReady(ctx context.Context) error {
+	if a.shuttingDown.Load() {
+		return errShuttingDown
+	}
+	if err := a.dbPool.Ping(ctx); err != nil {
+		a.l.Warn("database ping failed", slog.String("error", err.Error()))
+		return errDatabaseUnavailable
+	}
+	if err := db.CheckVersion(ctx, a.sqlDB, a.schemaVersion); err != nil {
+		a.l.Warn("database schema check failed", slog.String("error", err.Error()))
+		if errors.Is(err, db.ErrMigrationsPending) {
+			return db.ErrMigrationsPending
+		}
+		return errDatabaseUnavailable
+	}
 	return nil
 }
 
-func (a *App) setupRoutes() *gin.Engine {
+func (a *App) setupRoutes(tokens *auth.TokenVerifier) *gin.Engine {
 	teamRepo := postgres.NewTeamRepository(a.dbPool)
 	userRepo := postgres.NewUserRepository(a.dbPool)
 	prRepo := postgres.NewPRRepository(a.dbPool)
+	apiKeyRepo := postgres.NewAPIKeyRepository(a.dbPool)
+	idempotencyRepo := postgres.NewIdempotencyRepository(a.dbPool)
 
-	teamService := team.NewService(teamRepo, a.l)
-	userService := user.NewService(userRepo, a.l)
 	prService := pr.NewService(prRepo, teamRepo, userRepo, a.l)
-	statsService := stats.NewService(prRepo, a.l)
+	teamService := team.NewService(teamRepo, prService, a.l)
+	userService := user.NewService(userRepo, prService, a.l)
+	statsService := stats.NewService(prRepo, teamRepo, a.l)
+	authService := auth.NewService(apiKeyRepo, tokens, a.cfg.BootstrapAPIKey, a.l)
+	a.idempotencyService = idempotency.NewService(idempotencyRepo, a.cfg.IdempotencyTTL, a.cfg.IdempotencyLockTimeout, a.l)
 
 	teamHandler := handlers.NewTeamHandler(teamService, a.l)
 	userHandler := handlers.NewUserHandler(userService, a.l)
 	prHandler := handlers.NewPRHandler(prService, a.l)
 	statsHandler := handlers.NewStatsHandler(statsService, a.l)
+	healthHandler := handlers.NewHealthHandler(a.checkReady, a.l)
+	authHandler := handlers.NewAuthHandler(authService, a.l)
+	idempotencyHandler := handlers.NewIdempotencyHandler(a.idempotencyService, a.l)
+
+	prometheus.MustRegister(metrics.NewCollector(a.dbPool, prRepo))
 
 	router := gin.New()
-	router.Use(gin.Recovery())
+	router.Use(metrics.Middleware(), gin.Recovery())
+
+	// Probes and metrics are public
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	router.GET("/healthz", healthHandler.Liveness)
+	router.GET("/readyz", healthHandler.Readiness)
+
+	// Roles: admin manages teams, users and keys, service drives PRs from CI, read_only reads.
+	// Higher roles include lower ones
+	admin := authHandler.Require(domain.RoleAdmin)
+	service := authHandler.Require(domain.RoleService)
+	readOnly := authHandler.Require(domain.RoleReadOnly)
+
+	// POST endpoints replay the first response for retries with the same Idempotency-Key
+	idempotent := idempotencyHandler.Middleware()
 
 	teams := router.Group("/team")
-	teams.POST("/add", teamHandler.CreateTeam)
-	teams.GET("/get", teamHandler.GetTeam)
+	teams.POST("/add", admin, idempotent, teamHandler.CreateTeam)
+	teams.GET("/get", readOnly, teamHandler.GetTeam)
+	teams.GET("/list", readOnly, teamHandler.ListTeams)
+	teams.DELETE("", admin, teamHandler.DeleteTeam)
+	teams.POST("/updateSettings", admin, idempotent, teamHandler.UpdateSettings)
+	teams.POST("/deactivateUsers", admin, idempotent, teamHandler.DeactivateUsers)
+	teams.POST("/addMembers", admin, idempotent, teamHandler.AddMembers)
+	teams.POST("/removeMember", admin, idempotent, teamHandler.RemoveMember)
+	teams.POST("/moveMember", admin, idempotent, teamHandler.MoveMember)
 
 	users := router.Group("/users")
-	users.POST("/setIsActive", userHandler.SetIsActive)
-	users.GET("/getReview", userHandler.GetReview)
+	users.GET("/get", readOnly, userHandler.GetUser)
+	users.POST("/setIsActive", admin, idempotent, userHandler.SetIsActive)
+	users.GET("/getReview", readOnly, userHandler.GetReview)
+	users.GET("/list", readOnly, userHandler.ListUsers)
+	users.POST("/addAbsence", admin, idempotent, userHandler.CreateAbsence)
+	users.GET("/getAbsences", readOnly, userHandler.GetAbsences)
+	users.POST("/updateAbsence", admin, idempotent, userHandler.UpdateAbsence)
+	users.POST("/deleteAbsence", admin, idempotent, userHandler.DeleteAbsence)
 
 	pullRequest := router.Group("/pullRequest")
-	pullRequest.POST("/create", prHandler.CreatePR)
-	pullRequest.POST("/merge", prHandler.MergePR)
-	pullRequest.POST("/reassign", prHandler.ReassignReviewer)
+	pullRequest.GET("/get", readOnly, prHandler.GetPR)
+	pullRequest.GET("/getBatch", readOnly, prHandler.GetPRs)
+	pullRequest.POST("/create", service, idempotent, prHandler.CreatePR)
+	pullRequest.POST("/merge", service, idempotent, prHandler.MergePR)
+	pullRequest.POST("/ready", service, idempotent, prHandler.MarkReady)
+	pullRequest.POST("/close", service, idempotent, prHandler.ClosePR)
+	pullRequest.POST("/reopen", service, idempotent, prHandler.ReopenPR)
+	pullRequest.POST("/reassign", service, idempotent, prHandler.ReassignReviewer)
+	pullRequest.POST("/review", service, idempotent, prHandler.SubmitReview)
+	pullRequest.GET("/history", readOnly, prHandler.GetHistory)
+	pullRequest.GET("/list", readOnly, prHandler.ListPRs)
 
-	stat := router.Group("/stats")
+	stat := router.Group("/stats", readOnly)
 	stat.GET("/reviewers", statsHandler.GetReviewerStats)
 	stat.GET("/pullRequests", statsHandler.GetPRStats)
+	stat.GET("/latency", statsHandler.GetLatencyStats)
+	stat.GET("/fairness", statsHandler.GetFairness)
+
+	apiKeys := router.Group("/apiKeys", admin)
+	// Not idempotent on purpose: replaying would require storing the plain key
+	apiKeys.POST("/create", authHandler.CreateKey)
+	apiKeys.GET("/list", authHandler.ListKeys)
+	apiKeys.POST("/revoke", idempotent, authHandler.RevokeKey)
 
 	return router
 }
 
+// Delete expired idempotency keys until ctx is canceled
+func (a *App) cleanupIdempotencyKeys(ctx context.Context) {
+	ticker := time.NewTicker(idempotencyCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = a.idempotencyService.DeleteExpired(ctx)
+		}
+	}
+}
+
 func (a *App) Run() error {
 	a.l.Info("starting server", slog.String("address", a.server.Addr))
 	if err := a.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -124,6 +256,16 @@ func (a *App) Shutdown(ctx context.Context) error {
 
 	var errs []error
 
+	// Fail readiness first so load balancers stop routing new requests
+	a.shuttingDown.Store(true)
+	if a.cfg.ShutdownDrainDelay > 0 {
+		a.l.Info("draining traffic", slog.Duration("delay", a.cfg.ShutdownDrainDelay))
+		select {
+		case <-time.After(a.cfg.ShutdownDrainDelay):
+		case <-shutdownCtx.Done():
+		}
+	}
+
 	// Graceful shutdown of HTTP server
 	if a.server != nil {
 		if err := a.server.Shutdown(shutdownCtx); err != nil {
@@ -134,6 +276,11 @@ func (a *App) Shutdown(ctx context.Context) error {
 	}
 
 	// Close DB conn
+	if a.sqlDB != nil {
+		if err := a.sqlDB.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close database: %w", err))
+		}
+	}
 	if a.dbPool != nil {
 		a.dbPool.Close()
 		a.l.Info("database connections closed")
@@ -3,11 +3,29 @@ package config
 import (
 	"fmt"
 	"github.com/ilyakaznacheev/cleanenv"
+	"time"
 )
 
 type Config struct {
 	HTTPPort string `env:"HTTP_PORT" env-default:"8080"`
-	Postgres postgres
+	// Time between failing readiness and stopping the server, lets load balancers drain traffic
+	ShutdownDrainDelay time.Duration `env:"SHUTDOWN_DRAIN_DELAY" env-default:"5s"`
+	// Admin API key accepted without database lookup, used to issue the first keys
+	BootstrapAPIKey string `env:"BOOTSTRAP_API_KEY"`
+	// How long responses of requests with Idempotency-Key are replayed
+	IdempotencyTTL time.Duration `env:"IDEMPOTENCY_TTL" env-default:"24h"`
+	// How long a key stays locked by in-progress request before a retry can take it over
+	IdempotencyLockTimeout time.Duration `env:"IDEMPOTENCY_LOCK_TIMEOUT" env-default:"1m"`
+	Postgres               postgres
+	JWT                    jwt
+}
+
+// JWT validation uses either HMAC secret or public keys from a local JWKS file
+type jwt struct {
+	HMACSecret string `env:"JWT_HMAC_SECRET"`
+	JWKSFile   string `env:"JWT_JWKS_FILE"`
+	Issuer     string `env:"JWT_ISSUER"`
+	Audience   string `env:"JWT_AUDIENCE"`
 }
 
 type postgres struct {
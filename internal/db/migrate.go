@@ -1,14 +1,19 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"embed"
+	"errors"
+	"fmt"
 	"github.com/pressly/goose/v3"
 )
 
 //go:embed migrations/*.sql
 var embedMigrations embed.FS
 
+var ErrMigrationsPending = errors.New("database migrations are pending")
+
 func Migrate(db *sql.DB) error {
 	goose.SetBaseFS(embedMigrations)
 
@@ -22,3 +27,32 @@ func Migrate(db *sql.DB) error {
 
 	return nil
 }
+
+// LatestVersion returns version of the latest embedded migration. It changes goose global state,
+// so it is called once at startup and not from request handlers
+func LatestVersion() (int64, error) {
+	goose.SetBaseFS(embedMigrations)
+
+	migrations, err := goose.CollectMigrations("migrations", 0, goose.MaxVersion)
+	if err != nil {
+		return 0, fmt.Errorf("failed to collect migrations: %w", err)
+	}
+	latest, err := migrations.Last()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest migration: %w", err)
+	}
+	return latest.Version, nil
+}
+
+// CheckVersion reports ErrMigrationsPending when database schema is behind the expected version
+func CheckVersion(ctx context.Context, db *sql.DB, expected int64) error {
+	current, err := goose.GetDBVersionContext(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to get database version: %w", err)
+	}
+	if current < expected {
+		return fmt.Errorf("%w: version %d of %d", ErrMigrationsPending, current, expected)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Open database from TEST_DATABASE_URL with an empty public schema, the database is wiped
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" || testing.Short() {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	sqlDB, err := sql.Open("pgx", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	_, err = sqlDB.Exec(`DROP SCHEMA public CASCADE; CREATE SCHEMA public`)
+	require.NoError(t, err)
+
+	goose.SetBaseFS(embedMigrations)
+	require.NoError(t, goose.SetDialect("postgres"))
+
+	return sqlDB
+}
+
+func TestMigrate_LeastLoadedDefault(t *testing.T) {
+	sqlDB := openTestDB(t)
+
+	// Teams created before 00004
+	require.NoError(t, goose.UpTo(sqlDB, "migrations", 3))
+	_, err := sqlDB.Exec(`INSERT INTO teams (team_name) VALUES ('legacy')`)
+	require.NoError(t, err)
+	_, err = sqlDB.Exec(`INSERT INTO teams (team_name, reviewer_strategy) VALUES ('rotating', 'round_robin')`)
+	require.NoError(t, err)
+
+	require.NoError(t, goose.UpTo(sqlDB, "migrations", 4))
+	_, err = sqlDB.Exec(`INSERT INTO teams (team_name) VALUES ('fresh')`)
+	require.NoError(t, err)
+
+	strategies := make(map[string]string)
+	rows, err := sqlDB.Query(`SELECT team_name, reviewer_strategy FROM teams`)
+	require.NoError(t, err)
+	defer rows.Close()
+	for rows.Next() {
+		var name, strategy string
+		require.NoError(t, rows.Scan(&name, &strategy))
+		strategies[name] = strategy
+	}
+	require.NoError(t, rows.Err())
+
+	assert.Equal(t, map[string]string{
+		"legacy":   "least_loaded",
+		"rotating": "round_robin",
+		"fresh":    "least_loaded",
+	}, strategies)
+}
@@ -0,0 +1,17 @@
+-- +goose Up
+
+ALTER TABLE teams
+    ADD COLUMN reviewer_strategy TEXT NOT NULL DEFAULT 'random'
+        CHECK (reviewer_strategy IN ('random', 'least_loaded', 'round_robin', 'weighted'));
+
+ALTER TABLE users
+    ADD COLUMN review_weight INT NOT NULL DEFAULT 1 CHECK (review_weight > 0);
+
+CREATE INDEX idx_pull_requests_status
+    ON pull_requests(status);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_pull_requests_status;
+ALTER TABLE users DROP COLUMN IF EXISTS review_weight;
+ALTER TABLE teams DROP COLUMN IF EXISTS reviewer_strategy;
@@ -0,0 +1,13 @@
+-- +goose Up
+
+ALTER TABLE teams
+    ALTER COLUMN reviewer_strategy SET DEFAULT 'least_loaded';
+
+-- Teams created before strategies existed got 'random' only as the 00003 column default,
+-- move them to load-based assignment as well
+UPDATE teams SET reviewer_strategy = 'least_loaded' WHERE reviewer_strategy = 'random';
+
+-- +goose Down
+
+ALTER TABLE teams
+    ALTER COLUMN reviewer_strategy SET DEFAULT 'random';
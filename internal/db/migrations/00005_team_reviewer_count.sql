@@ -0,0 +1,14 @@
+-- +goose Up
+
+ALTER TABLE teams
+    ADD COLUMN min_reviewers INT NOT NULL DEFAULT 0,
+    ADD COLUMN max_reviewers INT NOT NULL DEFAULT 2,
+    ADD CONSTRAINT teams_reviewers_count_check
+        CHECK (min_reviewers >= 0 AND min_reviewers <= max_reviewers);
+
+-- +goose Down
+
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS teams_reviewers_count_check,
+    DROP COLUMN IF EXISTS max_reviewers,
+    DROP COLUMN IF EXISTS min_reviewers;
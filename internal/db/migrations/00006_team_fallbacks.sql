@@ -0,0 +1,18 @@
+-- +goose Up
+
+-- Create team_fallbacks table
+CREATE TABLE IF NOT EXISTS team_fallbacks (
+    team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    fallback_team_name TEXT NOT NULL REFERENCES teams(team_name) ON DELETE CASCADE,
+    priority INT NOT NULL,
+    PRIMARY KEY (team_name, fallback_team_name),
+    CHECK (team_name <> fallback_team_name)
+);
+
+ALTER TABLE pr_reviewers
+    ADD COLUMN is_fallback BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS is_fallback;
+DROP TABLE IF EXISTS team_fallbacks;
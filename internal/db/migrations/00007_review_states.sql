@@ -0,0 +1,14 @@
+-- +goose Up
+
+ALTER TABLE pr_reviewers
+    ADD COLUMN state TEXT NOT NULL DEFAULT 'PENDING'
+        CHECK (state IN ('PENDING', 'APPROVED', 'CHANGES_REQUESTED', 'COMMENTED')),
+    ADD COLUMN assigned_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN reviewed_at TIMESTAMPTZ;
+
+-- +goose Down
+
+ALTER TABLE pr_reviewers
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS assigned_at,
+    DROP COLUMN IF EXISTS state;
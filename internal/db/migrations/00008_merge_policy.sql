@@ -0,0 +1,21 @@
+-- +goose Up
+
+ALTER TABLE teams
+    ADD COLUMN required_approvals INT NOT NULL DEFAULT 0 CHECK (required_approvals >= 0),
+    ADD COLUMN block_on_changes_requested BOOLEAN NOT NULL DEFAULT TRUE;
+
+ALTER TABLE pull_requests
+    ADD COLUMN required_approvals INT CHECK (required_approvals >= 0),
+    ADD COLUMN merge_override_by TEXT,
+    ADD COLUMN merge_override_reason TEXT;
+
+-- +goose Down
+
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS merge_override_reason,
+    DROP COLUMN IF EXISTS merge_override_by,
+    DROP COLUMN IF EXISTS required_approvals;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS block_on_changes_requested,
+    DROP COLUMN IF EXISTS required_approvals;
@@ -0,0 +1,15 @@
+-- +goose Up
+
+ALTER TABLE pull_requests
+    DROP CONSTRAINT IF EXISTS pull_requests_status_check,
+    ADD CONSTRAINT pull_requests_status_check
+        CHECK (status IN ('DRAFT', 'OPEN', 'MERGED', 'CLOSED')),
+    ADD COLUMN closed_at TIMESTAMPTZ;
+
+-- +goose Down
+
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS closed_at,
+    DROP CONSTRAINT IF EXISTS pull_requests_status_check,
+    ADD CONSTRAINT pull_requests_status_check
+        CHECK (status IN ('OPEN', 'MERGED'));
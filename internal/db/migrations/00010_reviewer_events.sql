@@ -0,0 +1,26 @@
+-- +goose Up
+
+-- Create pr_reviewer_events table (append-only)
+CREATE TABLE IF NOT EXISTS pr_reviewer_events (
+    event_id BIGSERIAL PRIMARY KEY,
+    pr_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    event_type TEXT NOT NULL CHECK (event_type IN ('ASSIGN', 'REASSIGN', 'UNASSIGN', 'REVIEW')),
+    reviewer_id TEXT NOT NULL REFERENCES users(user_id),
+    previous_reviewer_id TEXT REFERENCES users(user_id),
+    review_state TEXT,
+    actor_id TEXT,
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_pr_reviewer_events_pr_id
+    ON pr_reviewer_events(pr_id, event_id);
+
+CREATE INDEX idx_pr_reviewer_events_reviewer_id
+    ON pr_reviewer_events(reviewer_id, created_at);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_pr_reviewer_events_reviewer_id;
+DROP INDEX IF EXISTS idx_pr_reviewer_events_pr_id;
+DROP TABLE IF EXISTS pr_reviewer_events;
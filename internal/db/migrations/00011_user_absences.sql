@@ -0,0 +1,20 @@
+-- +goose Up
+
+-- Create user_absences table, user is unavailable for review during [starts_at, ends_at)
+CREATE TABLE IF NOT EXISTS user_absences (
+    absence_id BIGSERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT chk_user_absences_range CHECK (starts_at < ends_at)
+);
+
+CREATE INDEX idx_user_absences_user_id
+    ON user_absences(user_id, ends_at);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_user_absences_user_id;
+DROP TABLE IF EXISTS user_absences;
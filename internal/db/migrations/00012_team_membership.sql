@@ -0,0 +1,9 @@
+-- +goose Up
+
+-- Users removed from their team have no team
+ALTER TABLE users ALTER COLUMN team_name DROP NOT NULL;
+
+-- +goose Down
+
+DELETE FROM users WHERE team_name IS NULL;
+ALTER TABLE users ALTER COLUMN team_name SET NOT NULL;
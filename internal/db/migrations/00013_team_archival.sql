@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Archived teams keep their history but are excluded from reviewer assignment
+ALTER TABLE teams ADD COLUMN archived_at TIMESTAMPTZ;
+
+-- +goose Down
+
+ALTER TABLE teams DROP COLUMN IF EXISTS archived_at;
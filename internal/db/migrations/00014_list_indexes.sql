@@ -0,0 +1,21 @@
+-- +goose Up
+
+-- Keyset pagination and filters of list endpoints
+CREATE INDEX idx_pull_requests_created_at
+    ON pull_requests(created_at, pull_request_id);
+
+CREATE INDEX idx_pull_requests_author_id
+    ON pull_requests(author_id, created_at);
+
+CREATE INDEX idx_pull_requests_status_created_at
+    ON pull_requests(status, created_at);
+
+CREATE INDEX idx_users_username
+    ON users(username, user_id);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_users_username;
+DROP INDEX IF EXISTS idx_pull_requests_status_created_at;
+DROP INDEX IF EXISTS idx_pull_requests_author_id;
+DROP INDEX IF EXISTS idx_pull_requests_created_at;
@@ -0,0 +1,15 @@
+-- +goose Up
+
+-- API keys are stored as SHA-256 hashes, the plain key is shown only once on creation
+CREATE TABLE IF NOT EXISTS api_keys (
+    key_id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('admin', 'service', 'read_only')),
+    key_hash BYTEA NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    revoked_at TIMESTAMPTZ
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- User who merged the PR, empty for merges made with API keys not bound to a user
+ALTER TABLE pull_requests ADD COLUMN merged_by TEXT;
+
+-- +goose Down
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS merged_by;
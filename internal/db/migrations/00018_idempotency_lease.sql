@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Lease of the in-progress request, a retry takes over the key after it expires
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
+
+-- +goose Down
+
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS locked_until;
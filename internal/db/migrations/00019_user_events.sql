@@ -0,0 +1,21 @@
+-- +goose Up
+
+-- Create user_events table (append-only), records who changed user status and team membership
+CREATE TABLE IF NOT EXISTS user_events (
+    event_id BIGSERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    event_type TEXT NOT NULL CHECK (event_type IN ('ACTIVATE', 'DEACTIVATE', 'JOIN_TEAM', 'LEAVE_TEAM', 'MOVE_TEAM')),
+    team_name TEXT,
+    previous_team_name TEXT,
+    actor_id TEXT,
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_user_events_user_id
+    ON user_events(user_id, event_id);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_user_events_user_id;
+DROP TABLE IF EXISTS user_events;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Owner of the lease, only the request holding it may complete or release the key
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS lock_token TEXT;
+
+-- +goose Down
+
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS lock_token;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Round-robin position of the team, shared by all replicas and kept across restarts
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS rr_cursor BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+
+ALTER TABLE teams DROP COLUMN IF EXISTS rr_cursor;
@@ -0,0 +1,45 @@
+package domain
+
+import "context"
+
+// Caller is the authenticated client of a request
+type Caller struct {
+	// Empty for API keys that are not bound to a user, e.g. CI
+	UserID string
+	Role   Role
+	KeyID  string
+}
+
+// CanActAs reports whether caller may act on behalf of the user. Anonymous callers, callers
+// without a user identity and admins are not restricted
+func (c *Caller) CanActAs(userID string) bool {
+	return c == nil || c.UserID == "" || c.Role == RoleAdmin || c.UserID == userID
+}
+
+// AuditID identifies the caller in audit records: user id, or API key id for keys without a user
+func (c *Caller) AuditID() string {
+	if c.UserID != "" {
+		return c.UserID
+	}
+	return "key:" + c.KeyID
+}
+
+type callerKey struct{}
+
+func WithCaller(ctx context.Context, caller *Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns nil when request is not authenticated
+func CallerFromContext(ctx context.Context) *Caller {
+	caller, _ := ctx.Value(callerKey{}).(*Caller)
+	return caller
+}
+
+// ActorID returns user id of the caller, fallback is used when request is not made on behalf of a user
+func ActorID(ctx context.Context, fallback string) string {
+	if caller := CallerFromContext(ctx); caller != nil && caller.UserID != "" {
+		return caller.UserID
+	}
+	return fallback
+}
@@ -12,11 +12,30 @@ const (
 	ErrCodeNoCandidate ErrorCode = "NO_CANDIDATE"
 	ErrCodeNotFound    ErrorCode = "NOT_FOUND"
 	ErrCodeBadRequest  ErrorCode = "BAD_REQUEST"
+
+	ErrCodeMergeBlocked      ErrorCode = "MERGE_BLOCKED"
+	ErrCodeInvalidTransition ErrorCode = "INVALID_STATUS_TRANSITION"
+	ErrCodePRNotOpen         ErrorCode = "PR_NOT_OPEN"
+	ErrCodeConcurrentUpdate  ErrorCode = "CONCURRENT_UPDATE"
+
+	ErrCodeUserInAnotherTeam ErrorCode = "USER_IN_ANOTHER_TEAM"
+	ErrCodeUserHasOpenPRs    ErrorCode = "USER_HAS_OPEN_PRS"
+
+	ErrCodeTeamArchived   ErrorCode = "TEAM_ARCHIVED"
+	ErrCodeTeamHasMembers ErrorCode = "TEAM_HAS_MEMBERS"
+	ErrCodeTeamHasOpenPRs ErrorCode = "TEAM_HAS_OPEN_PRS"
+
+	ErrCodeUnauthorized ErrorCode = "UNAUTHORIZED"
+	ErrCodeForbidden    ErrorCode = "FORBIDDEN"
+
+	ErrCodeIdempotencyKeyReused ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	ErrCodeIdempotencyInFlight  ErrorCode = "IDEMPOTENCY_IN_PROGRESS"
 )
 
 type Error struct {
 	Code    ErrorCode
 	Message string
+	Details []string
 }
 
 func (e *Error) Error() string {
@@ -29,3 +48,11 @@ func NewError(code ErrorCode, message string) *Error {
 		Message: message,
 	}
 }
+
+func NewErrorWithDetails(code ErrorCode, message string, details []string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+		Details: details,
+	}
+}
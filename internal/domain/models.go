@@ -1,14 +1,47 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type PRStatus string
 
 const (
+	StatusDraft  PRStatus = "DRAFT"
 	StatusOpen   PRStatus = "OPEN"
 	StatusMerged PRStatus = "MERGED"
+	StatusClosed PRStatus = "CLOSED"
+)
+
+type ReviewState string
+
+const (
+	ReviewPending          ReviewState = "PENDING"
+	ReviewApproved         ReviewState = "APPROVED"
+	ReviewChangesRequested ReviewState = "CHANGES_REQUESTED"
+	ReviewCommented        ReviewState = "COMMENTED"
 )
 
+type ReviewerStrategy string
+
+const (
+	StrategyRandom      ReviewerStrategy = "random"
+	StrategyLeastLoaded ReviewerStrategy = "least_loaded"
+	StrategyRoundRobin  ReviewerStrategy = "round_robin"
+	StrategyWeighted    ReviewerStrategy = "weighted"
+)
+
+const DefaultReviewerStrategy = StrategyLeastLoaded
+
+func (s ReviewerStrategy) IsValid() bool {
+	switch s {
+	case StrategyRandom, StrategyLeastLoaded, StrategyRoundRobin, StrategyWeighted:
+		return true
+	}
+	return false
+}
+
 type User struct {
 	ID       string `json:"user_id" binding:"required,min=1"`
 	Name     string `json:"username" binding:"required,min=1"`
@@ -16,15 +49,143 @@ type User struct {
 	IsActive *bool  `json:"is_active" binding:"required"`
 }
 
+// UserProfile is a user with availability and workload at the moment of request
+type UserProfile struct {
+	User
+	IsAbsent       bool         `json:"is_absent"`
+	CurrentAbsence *Absence     `json:"current_absence,omitempty"`
+	Workload       UserWorkload `json:"workload"`
+}
+
+type UserWorkload struct {
+	OpenReviews     int `json:"open_reviews"`
+	AuthoredOpenPRs int `json:"authored_open_prs"`
+	MergedPRs       int `json:"merged_prs"`
+}
+
 type Team struct {
-	Name    string       `json:"team_name" binding:"required,min=1"`
-	Members []TeamMember `json:"members" binding:"required,min=1,dive"`
+	Name       string        `json:"team_name" binding:"required,min=1"`
+	Members    []TeamMember  `json:"members" binding:"required,min=1,dive"`
+	Settings   *TeamSettings `json:"settings,omitempty"`
+	ArchivedAt *time.Time    `json:"archived_at,omitempty"`
+	// Position of the round-robin strategy in the team's members ordered by ID
+	RRCursor int64 `json:"-"`
+}
+
+// IsArchived reports whether team is retired from reviewer assignment
+func (t *Team) IsArchived() bool {
+	return t.ArchivedAt != nil
+}
+
+type TeamDeleteMode string
+
+const (
+	TeamDeleteArchive TeamDeleteMode = "archive"
+	TeamDeleteHard    TeamDeleteMode = "hard"
+)
+
+const (
+	DefaultMinReviewers = 0
+	DefaultMaxReviewers = 2
+	MaxReviewersLimit   = 10
+)
+
+type TeamSettings struct {
+	ReviewerStrategy ReviewerStrategy `json:"reviewer_strategy" binding:"omitempty,oneof=random least_loaded round_robin weighted"`
+	MinReviewers     int              `json:"min_reviewers" binding:"min=0"`
+	MaxReviewers     int              `json:"max_reviewers" binding:"min=0"`
+	FallbackTeams    []string         `json:"fallback_teams" binding:"omitempty,dive,required"`
+
+	RequiredApprovals       int  `json:"required_approvals" binding:"min=0"`
+	BlockOnChangesRequested bool `json:"block_on_changes_requested"`
+}
+
+func DefaultTeamSettings() *TeamSettings {
+	return &TeamSettings{
+		ReviewerStrategy: DefaultReviewerStrategy,
+		MinReviewers:     DefaultMinReviewers,
+		MaxReviewers:     DefaultMaxReviewers,
+		FallbackTeams:    []string{},
+
+		RequiredApprovals:       0,
+		BlockOnChangesRequested: true,
+	}
+}
+
+// Validate checks strategy, reviewers count and merge policy limits, fallback teams are checked by the caller
+func (s *TeamSettings) Validate() error {
+	if !s.ReviewerStrategy.IsValid() {
+		return NewError(ErrCodeBadRequest, "unknown reviewer strategy")
+	}
+	if s.MinReviewers < 0 || s.MaxReviewers > MaxReviewersLimit {
+		return NewError(ErrCodeBadRequest,
+			fmt.Sprintf("reviewers count must be between 0 and %d", MaxReviewersLimit))
+	}
+	if s.MinReviewers > s.MaxReviewers {
+		return NewError(ErrCodeBadRequest, "min_reviewers must not exceed max_reviewers")
+	}
+	if s.RequiredApprovals < 0 || s.RequiredApprovals > MaxReviewersLimit {
+		return NewError(ErrCodeBadRequest,
+			fmt.Sprintf("required_approvals must be between 0 and %d", MaxReviewersLimit))
+	}
+	return nil
+}
+
+// TeamSettingsPatch holds a partial update of team settings, nil fields are left unchanged
+type TeamSettingsPatch struct {
+	ReviewerStrategy *ReviewerStrategy `json:"reviewer_strategy" binding:"omitempty,oneof=random least_loaded round_robin weighted"`
+	MinReviewers     *int              `json:"min_reviewers" binding:"omitempty,min=0"`
+	MaxReviewers     *int              `json:"max_reviewers" binding:"omitempty,min=0"`
+	FallbackTeams    []string          `json:"fallback_teams" binding:"omitempty,dive,required"`
+
+	RequiredApprovals       *int  `json:"required_approvals" binding:"omitempty,min=0"`
+	BlockOnChangesRequested *bool `json:"block_on_changes_requested"`
+}
+
+// Apply returns a copy of settings with the patched fields replaced
+func (p *TeamSettingsPatch) Apply(settings TeamSettings) *TeamSettings {
+	if p.ReviewerStrategy != nil {
+		settings.ReviewerStrategy = *p.ReviewerStrategy
+	}
+	if p.MinReviewers != nil {
+		settings.MinReviewers = *p.MinReviewers
+	}
+	if p.MaxReviewers != nil {
+		settings.MaxReviewers = *p.MaxReviewers
+	}
+	if p.FallbackTeams != nil {
+		settings.FallbackTeams = p.FallbackTeams
+	}
+	if p.RequiredApprovals != nil {
+		settings.RequiredApprovals = *p.RequiredApprovals
+	}
+	if p.BlockOnChangesRequested != nil {
+		settings.BlockOnChangesRequested = *p.BlockOnChangesRequested
+	}
+	return &settings
 }
 
 type TeamMember struct {
-	ID       string `json:"user_id" binding:"required,min=1"`
-	Name     string `json:"username" binding:"required,min=1"`
-	IsActive *bool  `json:"is_active" binding:"required"`
+	ID           string `json:"user_id" binding:"required,min=1"`
+	Name         string `json:"username" binding:"required,min=1"`
+	IsActive     *bool  `json:"is_active" binding:"required"`
+	ReviewWeight int    `json:"review_weight,omitempty" binding:"omitempty,min=1,max=100"`
+	IsAbsent     bool   `json:"is_absent"`
+}
+
+// IsAvailable reports whether member can be assigned as a reviewer
+func (m TeamMember) IsAvailable() bool {
+	return m.IsActive != nil && *m.IsActive && !m.IsAbsent
+}
+
+// Absence is a period when user is unavailable for review
+type Absence struct {
+	ID        int64     `json:"absence_id"`
+	UserID    string    `json:"user_id"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type PullRequest struct {
@@ -33,26 +194,415 @@ type PullRequest struct {
 	AuthorID          string     `json:"author_id" binding:"required,min=1"`
 	Status            PRStatus   `json:"status" binding:"required"`
 	AssignedReviewers []string   `json:"assigned_reviewers" binding:"required"`
+	FallbackReviewers []string   `json:"fallback_reviewers,omitempty"`
+	Reviewers         []Reviewer `json:"reviewers"`
+	RequiredApprovals *int       `json:"required_approvals,omitempty"`
 	CreatedAt         time.Time  `json:"createdAt" binding:"required"`
 	MergedAt          *time.Time `json:"mergedAt,omitempty"`
+	MergedBy          string     `json:"merged_by,omitempty"`
+	ClosedAt          *time.Time `json:"closedAt,omitempty"`
+
+	MergeOverride *MergeOverride `json:"merge_override,omitempty"`
+}
+
+// MergePolicyViolations returns reasons why PR cannot be merged under team settings,
+// PR's own required approvals take precedence over team's
+func (pr *PullRequest) MergePolicyViolations(settings *TeamSettings) []string {
+	if settings == nil {
+		settings = DefaultTeamSettings()
+	}
+
+	requiredApprovals := settings.RequiredApprovals
+	if pr.RequiredApprovals != nil {
+		requiredApprovals = *pr.RequiredApprovals
+	}
+
+	var (
+		reasons   []string
+		approvals int
+	)
+	for _, reviewer := range pr.Reviewers {
+		switch reviewer.State {
+		case ReviewApproved:
+			approvals++
+		case ReviewChangesRequested:
+			if settings.BlockOnChangesRequested {
+				reasons = append(reasons, fmt.Sprintf("changes requested by %s", reviewer.UserID))
+			}
+		}
+	}
+
+	if approvals < requiredApprovals {
+		reasons = append(reasons, fmt.Sprintf("%d of %d required approvals", approvals, requiredApprovals))
+	}
+
+	return reasons
+}
+
+// MergeOverride records who forced a merge past the merge policy and why
+type MergeOverride struct {
+	ActorID string `json:"actor_id"`
+	Reason  string `json:"reason"`
+}
+
+// MergeOptions are set by the caller, the override actor is taken from the authenticated caller
+type MergeOptions struct {
+	Force  bool
+	Reason string
+}
+
+type Reviewer struct {
+	UserID     string      `json:"user_id"`
+	State      ReviewState `json:"state"`
+	IsFallback bool        `json:"is_fallback"`
+	AssignedAt time.Time   `json:"assigned_at"`
+	ReviewedAt *time.Time  `json:"reviewed_at,omitempty"`
+}
+
+// ReviewerOptions overrides reviewer selection for a single PR
+type ReviewerOptions struct {
+	ReviewersCount    *int
+	RequiredReviewers []string
+	ExcludedReviewers []string
+	RequiredApprovals *int
+}
+
+// HasReviewerOverrides reports whether options change reviewer selection
+func (o ReviewerOptions) HasReviewerOverrides() bool {
+	return o.ReviewersCount != nil || len(o.RequiredReviewers) > 0 || len(o.ExcludedReviewers) > 0
+}
+
+type ReviewerEventType string
+
+const (
+	EventAssign   ReviewerEventType = "ASSIGN"
+	EventReassign ReviewerEventType = "REASSIGN"
+	EventUnassign ReviewerEventType = "UNASSIGN"
+	EventReview   ReviewerEventType = "REVIEW"
+)
+
+// ReviewerEvent is an entry of PR reviewer assignment history
+type ReviewerEvent struct {
+	ID                 int64             `json:"event_id"`
+	PRID               string            `json:"pull_request_id"`
+	Type               ReviewerEventType `json:"event_type"`
+	ReviewerID         string            `json:"reviewer_id"`
+	PreviousReviewerID string            `json:"previous_reviewer_id,omitempty"`
+	ReviewState        ReviewState       `json:"review_state,omitempty"`
+	ActorID            string            `json:"actor_id,omitempty"`
+	Reason             string            `json:"reason,omitempty"`
+	CreatedAt          time.Time         `json:"created_at"`
+}
+
+type UserEventType string
+
+const (
+	EventActivate   UserEventType = "ACTIVATE"
+	EventDeactivate UserEventType = "DEACTIVATE"
+	EventJoinTeam   UserEventType = "JOIN_TEAM"
+	EventLeaveTeam  UserEventType = "LEAVE_TEAM"
+	EventMoveTeam   UserEventType = "MOVE_TEAM"
+)
+
+// UserEvent is an entry of user status and team membership history
+type UserEvent struct {
+	ID               int64         `json:"event_id"`
+	UserID           string        `json:"user_id"`
+	Type             UserEventType `json:"event_type"`
+	TeamName         string        `json:"team_name,omitempty"`
+	PreviousTeamName string        `json:"previous_team_name,omitempty"`
+	ActorID          string        `json:"actor_id,omitempty"`
+	Reason           string        `json:"reason,omitempty"`
+	CreatedAt        time.Time     `json:"created_at"`
+}
+
+// Audit describes who performed an action and why
+type Audit struct {
+	ActorID string
+	Reason  string
+}
+
+// ReassignmentReport lists open reviews moved away from a reviewer and the ones left in place
+type ReassignmentReport struct {
+	Reassigned []ReviewReassignment `json:"reassigned"`
+	Failed     []FailedReassignment `json:"failed"`
+}
+
+type ReviewReassignment struct {
+	PRID          string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
+}
+
+type FailedReassignment struct {
+	PRID       string    `json:"pull_request_id"`
+	ReviewerID string    `json:"reviewer_id"`
+	Code       ErrorCode `json:"code"`
+	Reason     string    `json:"reason"`
+}
+
+// DeactivationReport describes bulk deactivation of team members grouped by user and by PR
+type DeactivationReport struct {
+	TeamName     string             `json:"team_name"`
+	Users        []UserReassignment `json:"users"`
+	PullRequests []PRReassignment   `json:"pull_requests"`
+}
+
+type UserReassignment struct {
+	UserID string `json:"user_id"`
+	ReassignmentReport
+}
+
+type PRReassignment struct {
+	PRID string `json:"pull_request_id"`
+	ReassignmentReport
 }
 
 type PullRequestShort struct {
-	ID       string   `json:"pull_request_id"`
-	Name     string   `json:"pull_request_name"`
-	AuthorID string   `json:"author_id"`
-	Status   PRStatus `json:"status"`
+	ID        string     `json:"pull_request_id"`
+	Name      string     `json:"pull_request_name"`
+	AuthorID  string     `json:"author_id"`
+	Status    PRStatus   `json:"status"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
 }
 
 type ReviewerStat struct {
-	UserID        string `json:"user_id"`
-	AssignedCount int    `json:"assigned_count"`
+	Bucket        *time.Time `json:"bucket,omitempty"`
+	UserID        string     `json:"user_id"`
+	AssignedCount int        `json:"assigned_count"`
 }
 
 type PullRequestStat struct {
-	PullRequestID   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorID        string `json:"author_id"`
-	Status          string `json:"status"`
-	ReviewerCount   int    `json:"reviewer_count"`
+	Bucket          *time.Time `json:"bucket,omitempty"`
+	PullRequestID   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	AuthorID        string     `json:"author_id"`
+	Status          string     `json:"status"`
+	ReviewerCount   int        `json:"reviewer_count"`
+}
+
+type StatsGroupBy string
+
+const (
+	GroupByDay   StatsGroupBy = "day"
+	GroupByWeek  StatsGroupBy = "week"
+	GroupByMonth StatsGroupBy = "month"
+)
+
+func (g StatsGroupBy) IsValid() bool {
+	switch g {
+	case GroupByDay, GroupByWeek, GroupByMonth:
+		return true
+	}
+	return false
+}
+
+// StatsDateField is a PR timestamp used for stats window and buckets
+type StatsDateField string
+
+const (
+	StatsByCreatedAt StatsDateField = "created_at"
+	StatsByMergedAt  StatsDateField = "merged_at"
+)
+
+// StatsFilter restricts stats to PRs with date field in [From, To), buckets are UTC-aligned
+type StatsFilter struct {
+	From      *time.Time
+	To        *time.Time
+	TeamName  string
+	DateField StatsDateField
+	GroupBy   StatsGroupBy
+}
+
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 200
+
+	MaxBatchSize = 100
+)
+
+// PageRequest is a keyset pagination request, Cursor is the opaque next_cursor of the previous page
+type PageRequest struct {
+	Limit  int
+	Cursor string
+	SortBy string
+	Order  SortOrder
+}
+
+// Normalize applies defaults and validates page against the sort fields allowed for the list
+func (p *PageRequest) Normalize(defaultSortBy string, defaultOrder SortOrder, sortFields ...string) error {
+	if p.Limit == 0 {
+		p.Limit = DefaultPageLimit
+	}
+	if p.Limit < 0 || p.Limit > MaxPageLimit {
+		return NewError(ErrCodeBadRequest, fmt.Sprintf("limit must be between 1 and %d", MaxPageLimit))
+	}
+
+	if p.Order == "" {
+		p.Order = defaultOrder
+	}
+	if p.Order != SortAsc && p.Order != SortDesc {
+		return NewError(ErrCodeBadRequest, "order must be asc or desc")
+	}
+
+	if p.SortBy == "" {
+		p.SortBy = defaultSortBy
+	}
+	for _, field := range sortFields {
+		if p.SortBy == field {
+			return nil
+		}
+	}
+	return NewError(ErrCodeBadRequest, fmt.Sprintf("unknown sort field %s", p.SortBy))
+}
+
+const (
+	TeamSortName = "team_name"
+
+	UserSortID   = "user_id"
+	UserSortName = "username"
+
+	PRSortCreatedAt = "created_at"
+	PRSortID        = "pull_request_id"
+)
+
+type TeamFilter struct {
+	NamePrefix string
+	Archived   *bool
+	PageRequest
+}
+
+type UserFilter struct {
+	TeamName string
+	IsActive *bool
+	Search   string
+	PageRequest
+}
+
+type PRFilter struct {
+	Status      PRStatus
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	PageRequest
+}
+
+type TeamSummary struct {
+	Name          string     `json:"team_name"`
+	MembersCount  int        `json:"members_count"`
+	ActiveMembers int        `json:"active_members_count"`
+	ArchivedAt    *time.Time `json:"archived_at,omitempty"`
+}
+
+// LatencyPercentiles of durations in seconds, percentiles are null without samples
+type LatencyPercentiles struct {
+	Count int      `json:"count"`
+	P50   *float64 `json:"p50"`
+	P90   *float64 `json:"p90"`
+	P99   *float64 `json:"p99"`
+}
+
+type LatencyStat struct {
+	TimeToFirstReview LatencyPercentiles `json:"time_to_first_review"`
+	TimeToApproval    LatencyPercentiles `json:"time_to_approval"`
+	TimeToMerge       LatencyPercentiles `json:"time_to_merge"`
+}
+
+type TeamLatencyStat struct {
+	TeamName string `json:"team_name"`
+	LatencyStat
+}
+
+type ReviewerLatencyStat struct {
+	UserID string `json:"user_id"`
+	LatencyStat
+}
+
+type LatencyReport struct {
+	Overall   LatencyStat           `json:"overall"`
+	Teams     []TeamLatencyStat     `json:"teams"`
+	Reviewers []ReviewerLatencyStat `json:"reviewers"`
+}
+
+// TeamFairness describes how evenly reviewer assignments are spread across active team members
+type TeamFairness struct {
+	TeamName         string           `json:"team_name"`
+	ReviewerStrategy ReviewerStrategy `json:"reviewer_strategy"`
+	ActiveMembers    int              `json:"active_members_count"`
+	TotalAssignments int              `json:"total_assignments"`
+	Mean             float64          `json:"mean"`
+	StdDev           float64          `json:"stddev"`
+	Gini             float64          `json:"gini"`
+	// Null when some active member has no assignments
+	MaxMinRatio *float64       `json:"max_min_ratio"`
+	Members     []ReviewerStat `json:"members"`
+}
+
+// Role grants access to endpoints, higher roles include permissions of lower ones
+type Role string
+
+const (
+	RoleReadOnly Role = "read_only"
+	RoleService  Role = "service"
+	RoleAdmin    Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RoleReadOnly: 1,
+	RoleService:  2,
+	RoleAdmin:    3,
+}
+
+func (r Role) IsValid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// Allows reports whether role grants access to endpoints requiring the given role
+func (r Role) Allows(required Role) bool {
+	return r.IsValid() && roleRanks[r] >= roleRanks[required]
+}
+
+// APIKey identifies a client, the secret itself is never stored
+type APIKey struct {
+	ID        string     `json:"key_id"`
+	Name      string     `json:"name"`
+	Role      Role       `json:"role"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// IdempotencyRecord is the stored outcome of a request made with Idempotency-Key,
+// keys are scoped by caller so different clients never share them
+type IdempotencyRecord struct {
+	Scope       string
+	Key         string
+	RequestHash []byte
+	// Zero while the first request is in progress
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	// Lease of the in-progress request and its owner, zero once completed
+	LockedUntil time.Time
+	LockToken   string
+}
+
+func (r *IdempotencyRecord) IsCompleted() bool {
+	return r.StatusCode != 0
+}
+
+// IsLockExpired reports whether in-progress request lost its lease, e.g. the process died mid-request
+func (r *IdempotencyRecord) IsLockExpired(now time.Time) bool {
+	return !r.IsCompleted() && !r.LockedUntil.After(now)
 }
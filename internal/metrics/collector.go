@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"time"
+)
+
+// Timeout of database queries made during a scrape
+const scrapeTimeout = 5 * time.Second
+
+type OpenPRCounter interface {
+	CountOpen(ctx context.Context) (int, error)
+}
+
+// Collector exposes pgxpool stats and gauges read from the database on scrape
+type Collector struct {
+	pool   *pgxpool.Pool
+	prRepo OpenPRCounter
+
+	acquiredConns    *prometheus.Desc
+	idleConns        *prometheus.Desc
+	constructingConn *prometheus.Desc
+	totalConns       *prometheus.Desc
+	maxConns         *prometheus.Desc
+	acquires         *prometheus.Desc
+	acquireDuration  *prometheus.Desc
+	canceledAcquires *prometheus.Desc
+	emptyAcquires    *prometheus.Desc
+	openPRs          *prometheus.Desc
+}
+
+func NewCollector(pool *pgxpool.Pool, prRepo OpenPRCounter) *Collector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, nil, nil)
+	}
+	return &Collector{
+		pool:   pool,
+		prRepo: prRepo,
+
+		acquiredConns:    desc("db_pool_acquired_connections", "Connections currently in use."),
+		idleConns:        desc("db_pool_idle_connections", "Idle connections in the pool."),
+		constructingConn: desc("db_pool_constructing_connections", "Connections being established."),
+		totalConns:       desc("db_pool_total_connections", "Total connections in the pool."),
+		maxConns:         desc("db_pool_max_connections", "Maximum size of the pool."),
+		acquires:         desc("db_pool_acquires_total", "Successful connection acquires."),
+		acquireDuration:  desc("db_pool_acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		canceledAcquires: desc("db_pool_canceled_acquires_total", "Acquires canceled by context."),
+		emptyAcquires:    desc("db_pool_empty_acquires_total", "Acquires that waited for a connection."),
+		openPRs:          desc("open_pull_requests", "Pull requests in OPEN status."),
+	}
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.constructingConn
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquires
+	ch <- c.acquireDuration
+	ch <- c.canceledAcquires
+	ch <- c.emptyAcquires
+	ch <- c.openPRs
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.constructingConn, prometheus.GaugeValue, float64(stat.ConstructingConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquires, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+	count, err := c.prRepo.CountOpen(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.openPRs, err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(c.openPRs, prometheus.GaugeValue, float64(count))
+}
@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "pr_service"
+
+// Operations that may fail to find a reviewer
+const (
+	OpAssign       = "assign"
+	OpReassign     = "reassign"
+	OpBulkReassign = "bulk_reassign"
+)
+
+var (
+	PRsWithoutReviewers = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pull_requests_opened_without_reviewers_total",
+		Help:      "Pull requests that became open with zero reviewers, on creation, ready for review or reopen.",
+	})
+
+	Reassignments = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reviewer_reassignments_total",
+		Help:      "Reviewer reassignments applied.",
+	})
+
+	NoCandidateErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "no_candidate_errors_total",
+		Help:      "Reviewer selections that found no candidate, by operation.",
+	}, []string{"operation"})
+)
@@ -5,9 +5,24 @@ import "errors"
 var (
 	ErrTeamAlreadyExists = errors.New("team name already exists")
 	ErrTeamNotFound      = errors.New("team not found")
+	ErrTeamArchived      = errors.New("team is archived")
+	ErrTeamHasMembers    = errors.New("team has members")
+	ErrTeamHasOpenPRs    = errors.New("team has open PRs")
 
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrUserInAnotherTeam = errors.New("user belongs to another team")
+	ErrUserHasOpenPRs    = errors.New("user has open PRs")
+	ErrAbsenceNotFound   = errors.New("absence not found")
 
 	ErrPRAlreadyExists = errors.New("PR id already exists")
 	ErrPRNotFound      = errors.New("PR not found")
+	ErrPRStatusChanged = errors.New("PR status changed concurrently")
+	ErrReviewerChanged = errors.New("PR reviewers changed concurrently")
+
+	ErrAPIKeyNotFound = errors.New("API key not found")
+
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+	ErrIdempotencyLeaseLost   = errors.New("idempotency key lease lost")
+
+	ErrInvalidCursor = errors.New("invalid page cursor")
 )
@@ -7,25 +7,111 @@ import (
 )
 
 type TeamRepository interface {
-	CreateWithMembers(ctx context.Context, team *domain.Team) error
+	CreateWithMembers(ctx context.Context, team *domain.Team, audit domain.Audit) error
 	GetByName(ctx context.Context, teamName string) (*domain.Team, error)
+	// GetByUserID returns ErrUserNotFound for unknown user and ErrTeamNotFound for user without a team
 	GetByUserID(ctx context.Context, userID string) (*domain.Team, error)
+	// UpdateSettings applies patch to the team's settings locked for update and validates the result
+	UpdateSettings(ctx context.Context, teamName string, patch *domain.TeamSettingsPatch) (*domain.TeamSettings, error)
 	Exists(ctx context.Context, teamName string) (bool, error)
+	AddMembers(ctx context.Context, teamName string, members []domain.TeamMember, audit domain.Audit) error
+	RemoveMember(
+		ctx context.Context,
+		teamName, userID string,
+		reassignments []domain.ReviewReassignment,
+		audit domain.Audit,
+	) error
+	MoveMember(ctx context.Context, userID, fromTeam, toTeam string, audit domain.Audit) error
+	// AdvanceRRCursor moves the team's round-robin cursor by count and returns its previous position
+	AdvanceRRCursor(ctx context.Context, teamName string, count int) (int64, error)
+	Archive(ctx context.Context, teamName string, archivedAt time.Time) error
+	Delete(ctx context.Context, teamName string) error
+	List(ctx context.Context, filter domain.TeamFilter) ([]domain.TeamSummary, string, error)
+	DeactivateMembers(
+		ctx context.Context,
+		teamName string,
+		userIDs []string,
+		reassignments []domain.ReviewReassignment,
+		audit domain.Audit,
+	) error
 }
 
 type UserRepository interface {
-	SetIsActive(ctx context.Context, userID string, isActive bool) error
+	SetIsActive(ctx context.Context, userID string, isActive bool, audit domain.Audit) error
+	// Deactivate deactivates user and applies planned reassignments of the user's reviews in one transaction
+	Deactivate(ctx context.Context, userID string, reassignments []domain.ReviewReassignment, audit domain.Audit) error
 	GetByID(ctx context.Context, userID string) (*domain.User, error)
 	GetPRsByUserID(ctx context.Context, userID string) ([]domain.PullRequestShort, error)
+	GetWorkload(ctx context.Context, userID string) (*domain.UserWorkload, error)
+	CreateAbsence(ctx context.Context, absence *domain.Absence) error
+	GetAbsences(ctx context.Context, userID string) ([]domain.Absence, error)
+	UpdateAbsence(ctx context.Context, absence *domain.Absence) error
+	DeleteAbsence(ctx context.Context, absenceID int64) error
+	List(ctx context.Context, filter domain.UserFilter) ([]domain.User, string, error)
 }
 
 type PRRepository interface {
-	Create(ctx context.Context, pullRequest *domain.PullRequest) error
-	Merge(ctx context.Context, prID string, mergedAt time.Time) error
+	Create(ctx context.Context, pullRequest *domain.PullRequest, audit domain.Audit) error
+	// Merge re-checks merge policy of settings against reviews locked in the transaction. Violated policy blocks
+	// the merge with MERGE_BLOCKED domain error unless override is given, returns override if it was recorded
+	Merge(
+		ctx context.Context,
+		prID string,
+		mergedAt time.Time,
+		mergedBy string,
+		settings *domain.TeamSettings,
+		override *domain.MergeOverride,
+	) (*domain.MergeOverride, error)
+	// UpdateStatus changes PR status, unassigns removed reviewers and assigns new ones in one transaction
+	UpdateStatus(
+		ctx context.Context,
+		prID string,
+		from, to domain.PRStatus,
+		newReviewers []domain.Reviewer,
+		removedReviewerIDs []string,
+		audit domain.Audit,
+	) error
 	GetByID(ctx context.Context, prID string) (*domain.PullRequest, error)
+	GetByIDs(ctx context.Context, prIDs []string) ([]domain.PullRequest, error)
 	GetReviewersIDs(ctx context.Context, prID string) ([]string, error)
-	ChangeReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error
+	GetOpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error)
+	ChangeReviewer(
+		ctx context.Context,
+		prID, oldReviewerID, newReviewerID string,
+		isFallback bool,
+		audit domain.Audit,
+	) error
+	SetReviewState(
+		ctx context.Context,
+		prID, reviewerID string,
+		state domain.ReviewState,
+		reviewedAt time.Time,
+		comment string,
+	) error
+	GetReviewerEvents(ctx context.Context, prID string) ([]domain.ReviewerEvent, error)
 	Exists(ctx context.Context, prID string) (bool, error)
-	GetReviewerStats(ctx context.Context) ([]domain.ReviewerStat, error)
-	GetRRStats(ctx context.Context) ([]domain.PullRequestStat, error)
+	CountOpen(ctx context.Context) (int, error)
+	List(ctx context.Context, filter domain.PRFilter) ([]domain.PullRequestShort, string, error)
+	GetReviewerStats(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerStat, error)
+	GetRRStats(ctx context.Context, filter domain.StatsFilter) ([]domain.PullRequestStat, error)
+	GetLatencyStats(ctx context.Context, filter domain.StatsFilter) (*domain.LatencyReport, error)
+}
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *domain.APIKey, keyHash []byte) error
+	GetByHash(ctx context.Context, keyHash []byte) (*domain.APIKey, error)
+	List(ctx context.Context) ([]domain.APIKey, error)
+	Revoke(ctx context.Context, keyID string, revokedAt time.Time) error
+}
+
+type IdempotencyRepository interface {
+	// Create stores in-progress record unless a live record with the same key exists
+	Create(ctx context.Context, record *domain.IdempotencyRecord) (bool, error)
+	Get(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error)
+	// TakeOver renews the lease of in-progress record whose lease has expired and hands it to record.LockToken
+	TakeOver(ctx context.Context, record *domain.IdempotencyRecord) (bool, error)
+	// Complete and Delete apply only while the lease is held by the given lock token
+	Complete(ctx context.Context, record *domain.IdempotencyRecord) error
+	Delete(ctx context.Context, scope, key, lockToken string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
 }
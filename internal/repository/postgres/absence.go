@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+)
+
+func (r *userRepository) CreateAbsence(ctx context.Context, absence *domain.Absence) error {
+	query := `
+		INSERT INTO user_absences (user_id, starts_at, ends_at, reason) 
+		VALUES ($1, $2, $3, NULLIF($4, ''))
+		RETURNING absence_id, created_at
+`
+	err := r.db.QueryRow(ctx, query, absence.UserID, absence.StartsAt, absence.EndsAt, absence.Reason).
+		Scan(&absence.ID, &absence.CreatedAt)
+	if err != nil {
+		if isForeignKeyError(err) {
+			return repository.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to create absence: %w", err)
+	}
+	return nil
+}
+
+func (r *userRepository) GetAbsences(ctx context.Context, userID string) ([]domain.Absence, error) {
+	query := `
+		SELECT absence_id, user_id, starts_at, ends_at, COALESCE(reason, ''), created_at 
+		FROM user_absences 
+		WHERE user_id = $1 
+		ORDER BY starts_at, absence_id
+`
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get absences: %w", err)
+	}
+	defer rows.Close()
+
+	absences := make([]domain.Absence, 0)
+	for rows.Next() {
+		var a domain.Absence
+		err := rows.Scan(&a.ID, &a.UserID, &a.StartsAt, &a.EndsAt, &a.Reason, &a.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan absence: %w", err)
+		}
+		absences = append(absences, a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating absences: %w", err)
+	}
+
+	return absences, nil
+}
+
+func (r *userRepository) UpdateAbsence(ctx context.Context, absence *domain.Absence) error {
+	query := `
+		UPDATE user_absences 
+		SET starts_at = $1, ends_at = $2, reason = NULLIF($3, '') 
+		WHERE absence_id = $4
+		RETURNING user_id, created_at
+`
+	err := r.db.QueryRow(ctx, query, absence.StartsAt, absence.EndsAt, absence.Reason, absence.ID).
+		Scan(&absence.UserID, &absence.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrAbsenceNotFound
+		}
+		return fmt.Errorf("failed to update absence: %w", err)
+	}
+	return nil
+}
+
+func (r *userRepository) DeleteAbsence(ctx context.Context, absenceID int64) error {
+	res, err := r.db.Exec(ctx, `DELETE FROM user_absences WHERE absence_id = $1`, absenceID)
+	if err != nil {
+		return fmt.Errorf("failed to delete absence: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrAbsenceNotFound
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"time"
+)
+
+type apiKeyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAPIKeyRepository(db *pgxpool.Pool) repository.APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *domain.APIKey, keyHash []byte) error {
+	query := `
+		INSERT INTO api_keys (key_id, name, role, key_hash) 
+		VALUES ($1, $2, $3, $4)
+		RETURNING created_at
+`
+	err := r.db.QueryRow(ctx, query, key.ID, key.Name, key.Role, keyHash).Scan(&key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create API key: %w", err)
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash []byte) (*domain.APIKey, error) {
+	var key domain.APIKey
+	query := `SELECT key_id, name, role, created_at, revoked_at FROM api_keys WHERE key_hash = $1`
+	err := r.db.QueryRow(ctx, query, keyHash).Scan(&key.ID, &key.Name, &key.Role, &key.CreatedAt, &key.RevokedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+	return &key, nil
+}
+
+func (r *apiKeyRepository) List(ctx context.Context) ([]domain.APIKey, error) {
+	query := `SELECT key_id, name, role, created_at, revoked_at FROM api_keys ORDER BY created_at, key_id`
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]domain.APIKey, 0)
+	for rows.Next() {
+		var key domain.APIKey
+		err := rows.Scan(&key.ID, &key.Name, &key.Role, &key.CreatedAt, &key.RevokedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan API key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating API keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// Revoke is idempotent, the first revocation time is kept
+func (r *apiKeyRepository) Revoke(ctx context.Context, keyID string, revokedAt time.Time) error {
+	query := `UPDATE api_keys SET revoked_at = COALESCE(revoked_at, $1) WHERE key_id = $2`
+	res, err := r.db.Exec(ctx, query, revokedAt, keyID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrAPIKeyNotFound
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/platonso/avito-pr-service/internal/domain"
+)
+
+func (r *prRepository) GetReviewerEvents(ctx context.Context, prID string) ([]domain.ReviewerEvent, error) {
+	query := `
+		SELECT event_id, pr_id, event_type, reviewer_id, 
+		       COALESCE(previous_reviewer_id, ''), COALESCE(review_state, ''), 
+		       COALESCE(actor_id, ''), COALESCE(reason, ''), created_at
+		FROM pr_reviewer_events
+		WHERE pr_id = $1
+		ORDER BY event_id
+`
+	rows, err := r.db.Query(ctx, query, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewer events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]domain.ReviewerEvent, 0)
+	for rows.Next() {
+		var e domain.ReviewerEvent
+		err := rows.Scan(&e.ID, &e.PRID, &e.Type, &e.ReviewerID,
+			&e.PreviousReviewerID, &e.ReviewState, &e.ActorID, &e.Reason, &e.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reviewer events: %w", err)
+	}
+
+	return events, nil
+}
+
+func insertReviewerEvent(ctx context.Context, tx pgx.Tx, e domain.ReviewerEvent) error {
+	query := `
+		INSERT INTO pr_reviewer_events (
+			pr_id, event_type, reviewer_id, previous_reviewer_id, review_state, actor_id, reason
+		)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, ''), NULLIF($7, ''))
+`
+	_, err := tx.Exec(ctx, query,
+		e.PRID, string(e.Type), e.ReviewerID, e.PreviousReviewerID, string(e.ReviewState), e.ActorID, e.Reason)
+	if err != nil {
+		return fmt.Errorf("failed to record reviewer event: %w", err)
+	}
+	return nil
+}
+
+func insertUserEvent(ctx context.Context, tx pgx.Tx, e domain.UserEvent) error {
+	query := `
+		INSERT INTO user_events (user_id, event_type, team_name, previous_team_name, actor_id, reason)
+		VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, ''))
+`
+	_, err := tx.Exec(ctx, query, e.UserID, string(e.Type), e.TeamName, e.PreviousTeamName, e.ActorID, e.Reason)
+	if err != nil {
+		return fmt.Errorf("failed to record user event: %w", err)
+	}
+	return nil
+}
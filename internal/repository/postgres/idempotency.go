@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"time"
+)
+
+type idempotencyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewIdempotencyRepository(db *pgxpool.Pool) repository.IdempotencyRepository {
+	return &idempotencyRepository{db: db}
+}
+
+func (r *idempotencyRepository) Create(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+	// Expired record no longer reserves the key
+	deleteQuery := `DELETE FROM idempotency_keys WHERE scope = $1 AND idempotency_key = $2 AND expires_at <= now()`
+	_, err := r.db.Exec(ctx, deleteQuery, record.Scope, record.Key)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete expired idempotency key: %w", err)
+	}
+
+	query := `
+		INSERT INTO idempotency_keys (scope, idempotency_key, request_hash, expires_at, locked_until, lock_token) 
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (scope, idempotency_key) DO NOTHING
+		RETURNING created_at
+`
+	err = r.db.QueryRow(ctx, query,
+		record.Scope, record.Key, record.RequestHash, record.ExpiresAt, record.LockedUntil, record.LockToken,
+	).Scan(&record.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to create idempotency key: %w", err)
+	}
+	return true, nil
+}
+
+func (r *idempotencyRepository) Get(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error) {
+	record := domain.IdempotencyRecord{Scope: scope, Key: key}
+	var statusCode *int
+	var contentType *string
+	var lockedUntil *time.Time
+	query := `
+		SELECT request_hash, status_code, content_type, response_body, created_at, expires_at, locked_until 
+		FROM idempotency_keys 
+		WHERE scope = $1 AND idempotency_key = $2 AND expires_at > now()
+`
+	err := r.db.QueryRow(ctx, query, scope, key).Scan(
+		&record.RequestHash,
+		&statusCode,
+		&contentType,
+		&record.Body,
+		&record.CreatedAt,
+		&record.ExpiresAt,
+		&lockedUntil,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrIdempotencyKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	if statusCode != nil {
+		record.StatusCode = *statusCode
+	}
+	if contentType != nil {
+		record.ContentType = *contentType
+	}
+	if lockedUntil != nil {
+		record.LockedUntil = *lockedUntil
+	}
+	return &record, nil
+}
+
+func (r *idempotencyRepository) TakeOver(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+	// Lease is checked again, so only one of concurrent retries takes over the key
+	query := `
+		UPDATE idempotency_keys 
+		SET locked_until = $1, lock_token = $2, expires_at = $3
+		WHERE scope = $4 AND idempotency_key = $5 AND request_hash = $6 
+		  AND status_code IS NULL AND (locked_until IS NULL OR locked_until <= now())
+`
+	res, err := r.db.Exec(ctx, query,
+		record.LockedUntil, record.LockToken, record.ExpiresAt, record.Scope, record.Key, record.RequestHash)
+	if err != nil {
+		return false, fmt.Errorf("failed to take over idempotency key: %w", err)
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+func (r *idempotencyRepository) Complete(ctx context.Context, record *domain.IdempotencyRecord) error {
+	// Request that lost its lease must not overwrite the reservation of the retry that took over
+	query := `
+		UPDATE idempotency_keys 
+		SET status_code = $1, content_type = $2, response_body = $3, locked_until = NULL, lock_token = NULL
+		WHERE scope = $4 AND idempotency_key = $5 AND status_code IS NULL AND lock_token = $6
+`
+	res, err := r.db.Exec(ctx, query,
+		record.StatusCode, record.ContentType, record.Body, record.Scope, record.Key, record.LockToken)
+	if err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrIdempotencyLeaseLost
+	}
+	return nil
+}
+
+func (r *idempotencyRepository) Delete(ctx context.Context, scope, key, lockToken string) error {
+	query := `
+		DELETE FROM idempotency_keys 
+		WHERE scope = $1 AND idempotency_key = $2 AND status_code IS NULL AND lock_token = $3
+`
+	if _, err := r.db.Exec(ctx, query, scope, key, lockToken); err != nil {
+		return fmt.Errorf("failed to delete idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	res, err := r.db.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyRepository_StaleLeaseAfterTakeOver(t *testing.T) {
+	ctx := context.Background()
+	repo := NewIdempotencyRepository(newTestPool(t))
+
+	now := time.Now()
+	first := &domain.IdempotencyRecord{
+		Scope:       "key:ci",
+		Key:         "k1",
+		RequestHash: []byte("hash"),
+		ExpiresAt:   now.Add(time.Hour),
+		LockedUntil: now.Add(-time.Second),
+		LockToken:   "first",
+	}
+	created, err := repo.Create(ctx, first)
+	require.NoError(t, err)
+	require.True(t, created)
+
+	retry := *first
+	retry.LockedUntil = now.Add(time.Minute)
+	retry.LockToken = "retry"
+	taken, err := repo.TakeOver(ctx, &retry)
+	require.NoError(t, err)
+	require.True(t, taken)
+
+	// Stale release keeps the reservation of the retry
+	require.NoError(t, repo.Delete(ctx, first.Scope, first.Key, first.LockToken))
+	stored, err := repo.Get(ctx, first.Scope, first.Key)
+	require.NoError(t, err)
+	assert.False(t, stored.IsCompleted())
+	assert.False(t, stored.IsLockExpired(time.Now()))
+
+	// Late completion of the first request is rejected
+	first.StatusCode = 200
+	first.Body = []byte(`{"first":true}`)
+	assert.ErrorIs(t, repo.Complete(ctx, first), repository.ErrIdempotencyLeaseLost)
+
+	retry.StatusCode = 201
+	retry.ContentType = "application/json"
+	retry.Body = []byte(`{"retry":true}`)
+	require.NoError(t, repo.Complete(ctx, &retry))
+
+	stored, err = repo.Get(ctx, first.Scope, first.Key)
+	require.NoError(t, err)
+	assert.Equal(t, 201, stored.StatusCode)
+	assert.Equal(t, retry.Body, stored.Body)
+
+	// Completed response is not released
+	require.NoError(t, repo.Delete(ctx, retry.Scope, retry.Key, retry.LockToken))
+	_, err = repo.Get(ctx, first.Scope, first.Key)
+	assert.NoError(t, err)
+}
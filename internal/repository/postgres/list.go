@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"strings"
+)
+
+// Position of the last row of a page in keyset order, sort value is compared first and id breaks ties
+type cursor struct {
+	SortBy string `json:"s"`
+	Value  string `json:"v"`
+	ID     string `json:"id"`
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode cursor issued for the same sort field
+func decodeCursor(encoded, sortBy string) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, repository.ErrInvalidCursor
+	}
+	var c cursor
+	if err = json.Unmarshal(data, &c); err != nil || c.SortBy != sortBy {
+		return nil, repository.ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// Accumulate WHERE conditions with positional arguments
+type listQuery struct {
+	conds []string
+	args  []any
+}
+
+// Add argument and return its placeholder
+func (q *listQuery) arg(v any) string {
+	q.args = append(q.args, v)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+func (q *listQuery) where(cond string) {
+	q.conds = append(q.conds, cond)
+}
+
+// Restrict rows to those after the cursor position in the page order
+func (q *listQuery) after(sortExpr, idExpr string, order domain.SortOrder, value any, id string) {
+	op := ">"
+	if order == domain.SortDesc {
+		op = "<"
+	}
+	q.where(fmt.Sprintf("(%s, %s) %s (%s, %s)", sortExpr, idExpr, op, q.arg(value), q.arg(id)))
+}
+
+func (q *listQuery) whereClause() string {
+	if len(q.conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(q.conds, " AND ")
+}
+
+// Build ORDER BY and LIMIT clauses, one extra row is fetched to detect the next page
+func (q *listQuery) orderClause(sortExpr, idExpr string, order domain.SortOrder, limit int) string {
+	dir := "ASC"
+	if order == domain.SortDesc {
+		dir = "DESC"
+	}
+	return fmt.Sprintf(" ORDER BY %s %s, %s %s LIMIT %s", sortExpr, dir, idExpr, dir, q.arg(limit+1))
+}
+
+// Escape LIKE wildcards in user input
+func likePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,341 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+)
+
+func (r *teamRepository) AddMembers(
+	ctx context.Context,
+	teamName string,
+	members []domain.TeamMember,
+	audit domain.Audit,
+) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	err = lockActiveTeam(ctx, tx, teamName)
+	if err != nil {
+		return err
+	}
+
+	return upsertMembers(ctx, tx, teamName, members, audit)
+}
+
+func (r *teamRepository) RemoveMember(
+	ctx context.Context,
+	teamName, userID string,
+	reassignments []domain.ReviewReassignment,
+	audit domain.Audit,
+) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	// Authored PRs in progress keep the user in the team
+	var authored int
+	authoredQuery := `SELECT count(*) FROM pull_requests WHERE author_id = $1 AND status IN ($2, $3)`
+	err = tx.QueryRow(ctx, authoredQuery, userID, string(domain.StatusDraft), string(domain.StatusOpen)).Scan(&authored)
+	if err != nil {
+		return fmt.Errorf("failed to count authored PRs: %w", err)
+	}
+	if authored > 0 {
+		return repository.ErrUserHasOpenPRs
+	}
+
+	res, err := tx.Exec(ctx, `UPDATE users SET team_name = NULL WHERE user_id = $1 AND team_name = $2`, userID, teamName)
+	if err != nil {
+		return fmt.Errorf("failed to remove team member: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrUserNotFound
+	}
+
+	err = insertUserEvent(ctx, tx, domain.UserEvent{
+		UserID:           userID,
+		Type:             domain.EventLeaveTeam,
+		PreviousTeamName: teamName,
+		ActorID:          audit.ActorID,
+		Reason:           audit.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = reassignReviewers(ctx, tx, reassignments, audit)
+	if err != nil {
+		return err
+	}
+
+	// Reviews without a replacement are dropped
+	return unassignOpenReviews(ctx, tx, userID, audit)
+}
+
+func (r *teamRepository) MoveMember(ctx context.Context, userID, fromTeam, toTeam string, audit domain.Audit) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	err = lockActiveTeam(ctx, tx, toTeam)
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(ctx, `UPDATE users SET team_name = $1 WHERE user_id = $2 AND team_name = $3`, toTeam, userID, fromTeam)
+	if err != nil {
+		return fmt.Errorf("failed to move team member: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrUserNotFound
+	}
+
+	err = insertUserEvent(ctx, tx, domain.UserEvent{
+		UserID:           userID,
+		Type:             domain.EventMoveTeam,
+		TeamName:         toTeam,
+		PreviousTeamName: fromTeam,
+		ActorID:          audit.ActorID,
+		Reason:           audit.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Open reviews stay with the user, they become fallback ones when author is in another team
+	reviewsQuery := `
+		UPDATE pr_reviewers prr
+		SET is_fallback = (SELECT u.team_name FROM users u WHERE u.user_id = pr.author_id) IS DISTINCT FROM $1
+		FROM pull_requests pr
+		WHERE prr.pr_id = pr.pull_request_id AND prr.reviewer_id = $2 AND pr.status = $3
+`
+	_, err = tx.Exec(ctx, reviewsQuery, toTeam, userID, string(domain.StatusOpen))
+	if err != nil {
+		return fmt.Errorf("failed to update moved reviewer: %w", err)
+	}
+
+	// Authored open PRs follow the author, their reviewers are checked against the new team
+	authoredQuery := `
+		UPDATE pr_reviewers prr
+		SET is_fallback = (SELECT u.team_name FROM users u WHERE u.user_id = prr.reviewer_id) IS DISTINCT FROM $1
+		FROM pull_requests pr
+		WHERE prr.pr_id = pr.pull_request_id AND pr.author_id = $2 AND pr.status = $3
+`
+	_, err = tx.Exec(ctx, authoredQuery, toTeam, userID, string(domain.StatusOpen))
+	if err != nil {
+		return fmt.Errorf("failed to update reviewers of moved author: %w", err)
+	}
+
+	return nil
+}
+
+// Lock team row so it cannot be archived or removed concurrently, archived teams accept no members
+func lockActiveTeam(ctx context.Context, tx pgx.Tx, teamName string) error {
+	var archived bool
+	query := `SELECT archived_at IS NOT NULL FROM teams WHERE team_name = $1 FOR UPDATE`
+	err := tx.QueryRow(ctx, query, teamName).Scan(&archived)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrTeamNotFound
+		}
+		return fmt.Errorf("failed to get team: %w", err)
+	}
+	if archived {
+		return repository.ErrTeamArchived
+	}
+	return nil
+}
+
+// Create users or attach users without a team, members of other teams are rejected.
+// Users that were not in the team yet get a join event
+func upsertMembers(
+	ctx context.Context,
+	tx pgx.Tx,
+	teamName string,
+	members []domain.TeamMember,
+	audit domain.Audit,
+) error {
+	userIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		userIDs = append(userIDs, member.ID)
+	}
+	rows, err := tx.Query(ctx, `SELECT user_id FROM users WHERE team_name = $1 AND user_id = ANY($2)`, teamName, userIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get team members: %w", err)
+	}
+	existing, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return fmt.Errorf("failed to get team members: %w", err)
+	}
+	isMember := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		isMember[id] = true
+	}
+
+	query := `
+		INSERT INTO users (user_id, username, team_name, is_active, review_weight) 
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id)
+		DO UPDATE SET
+			username = $2,
+			team_name = $3,
+			is_active = $4,
+			review_weight = $5
+		WHERE users.team_name IS NULL OR users.team_name = $3
+`
+	for _, member := range members {
+		weight := member.ReviewWeight
+		if weight <= 0 {
+			weight = 1
+		}
+		res, err := tx.Exec(ctx, query, member.ID, member.Name, teamName, member.IsActive, weight)
+		if err != nil {
+			return fmt.Errorf("failed to create/update user: %w", err)
+		}
+		if res.RowsAffected() == 0 {
+			return repository.ErrUserInAnotherTeam
+		}
+
+		if isMember[member.ID] {
+			continue
+		}
+		err = insertUserEvent(ctx, tx, domain.UserEvent{
+			UserID:   member.ID,
+			Type:     domain.EventJoinTeam,
+			TeamName: teamName,
+			ActorID:  audit.ActorID,
+			Reason:   audit.Reason,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Move open reviews to new reviewers, reviewer is a fallback one if author belongs to another team
+func reassignReviewers(
+	ctx context.Context,
+	tx pgx.Tx,
+	reassignments []domain.ReviewReassignment,
+	audit domain.Audit,
+) error {
+	// Replacement from a team other than author's one is a fallback reviewer
+	query := `
+		UPDATE pr_reviewers prr
+		SET reviewer_id = $1,
+			is_fallback = (SELECT u.team_name FROM users u WHERE u.user_id = pr.author_id) IS DISTINCT FROM
+				(SELECT u.team_name FROM users u WHERE u.user_id = $1),
+			state = $2,
+			assigned_at = now(),
+			reviewed_at = NULL
+		FROM pull_requests pr
+		WHERE prr.pr_id = pr.pull_request_id 
+		  AND prr.pr_id = $3 
+		  AND prr.reviewer_id = $4 
+		  AND pr.status = $5
+`
+	for _, reassignment := range reassignments {
+		res, err := tx.Exec(ctx, query,
+			reassignment.NewReviewerID,
+			string(domain.ReviewPending),
+			reassignment.PRID,
+			reassignment.OldReviewerID,
+			string(domain.StatusOpen),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to reassign reviewer: %w", err)
+		}
+		if res.RowsAffected() == 0 {
+			return repository.ErrReviewerChanged
+		}
+
+		err = insertReviewerEvent(ctx, tx, domain.ReviewerEvent{
+			PRID:               reassignment.PRID,
+			Type:               domain.EventReassign,
+			ReviewerID:         reassignment.NewReviewerID,
+			PreviousReviewerID: reassignment.OldReviewerID,
+			ActorID:            audit.ActorID,
+			Reason:             audit.Reason,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove user from reviewers of open PRs
+func unassignOpenReviews(ctx context.Context, tx pgx.Tx, userID string, audit domain.Audit) error {
+	query := `
+		DELETE FROM pr_reviewers prr
+		USING pull_requests pr
+		WHERE prr.pr_id = pr.pull_request_id AND prr.reviewer_id = $1 AND pr.status = $2
+		RETURNING prr.pr_id
+`
+	rows, err := tx.Query(ctx, query, userID, string(domain.StatusOpen))
+	if err != nil {
+		return fmt.Errorf("failed to unassign reviewer: %w", err)
+	}
+	prIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return fmt.Errorf("failed to unassign reviewer: %w", err)
+	}
+
+	for _, prID := range prIDs {
+		err = insertReviewerEvent(ctx, tx, domain.ReviewerEvent{
+			PRID:       prID,
+			Type:       domain.EventUnassign,
+			ReviewerID: userID,
+			ActorID:    audit.ActorID,
+			Reason:     audit.Reason,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
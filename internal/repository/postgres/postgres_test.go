@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/platonso/avito-pr-service/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// Connect to TEST_DATABASE_URL and migrate an empty public schema, the database is wiped
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" || testing.Short() {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	pool, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	_, err = pool.Exec(context.Background(), `DROP SCHEMA public CASCADE; CREATE SCHEMA public`)
+	require.NoError(t, err)
+
+	sqlDB := sql.OpenDB(stdlib.GetConnector(*pool.Config().ConnConfig))
+	defer sqlDB.Close()
+	require.NoError(t, db.Migrate(sqlDB))
+
+	return pool
+}
@@ -20,7 +20,7 @@ func NewPRRepository(db *pgxpool.Pool) repository.PRRepository {
 	return &prRepository{db: db}
 }
 
-func (r *prRepository) Create(ctx context.Context, pr *domain.PullRequest) (err error) {
+func (r *prRepository) Create(ctx context.Context, pr *domain.PullRequest, audit domain.Audit) (err error) {
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -40,10 +40,10 @@ func (r *prRepository) Create(ctx context.Context, pr *domain.PullRequest) (err
 
 	// Create pull request
 	prQuery := `
-		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at) 
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, required_approvals) 
+		VALUES ($1, $2, $3, $4, $5, $6)
 `
-	_, err = tx.Exec(ctx, prQuery, pr.ID, pr.Name, pr.AuthorID, pr.Status, pr.CreatedAt)
+	_, err = tx.Exec(ctx, prQuery, pr.ID, pr.Name, pr.AuthorID, pr.Status, pr.CreatedAt, pr.RequiredApprovals)
 	if err != nil {
 		if isDuplicatePRKeyError(err) {
 			return repository.ErrPRAlreadyExists
@@ -52,50 +52,222 @@ func (r *prRepository) Create(ctx context.Context, pr *domain.PullRequest) (err
 	}
 
 	// Create pull request with reviewers
-	prReviewersQuery := `INSERT INTO pr_reviewers (pr_id, reviewer_id) VALUES ($1, $2)`
+	fallbackSet := make(map[string]bool, len(pr.FallbackReviewers))
+	for _, reviewerID := range pr.FallbackReviewers {
+		fallbackSet[reviewerID] = true
+	}
+	prReviewersQuery := `
+		INSERT INTO pr_reviewers (pr_id, reviewer_id, is_fallback, assigned_at) 
+		VALUES ($1, $2, $3, $4)
+`
 	for _, reviewerID := range pr.AssignedReviewers {
-		_, err = tx.Exec(ctx, prReviewersQuery, pr.ID, reviewerID)
+		_, err = tx.Exec(ctx, prReviewersQuery, pr.ID, reviewerID, fallbackSet[reviewerID], pr.CreatedAt)
 		if err != nil {
 			return fmt.Errorf("failed to assign reviewer: %w", err)
 		}
+
+		err = insertReviewerEvent(ctx, tx, domain.ReviewerEvent{
+			PRID:       pr.ID,
+			Type:       domain.EventAssign,
+			ReviewerID: reviewerID,
+			ActorID:    audit.ActorID,
+			Reason:     audit.Reason,
+		})
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func (r *prRepository) Merge(ctx context.Context, prID string, mergedAt time.Time) error {
+func (r *prRepository) Merge(
+	ctx context.Context,
+	prID string,
+	mergedAt time.Time,
+	mergedBy string,
+	settings *domain.TeamSettings,
+	override *domain.MergeOverride,
+) (recorded *domain.MergeOverride, err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	// Lock PR, reviews are not changed until the merge is committed
+	pr := &domain.PullRequest{ID: prID}
+	var status string
+	query := `SELECT status, required_approvals FROM pull_requests WHERE pull_request_id = $1 FOR UPDATE`
+	err = tx.QueryRow(ctx, query, prID).Scan(&status, &pr.RequiredApprovals)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrPRNotFound
+		}
+		return nil, fmt.Errorf("failed to lock pull request: %w", err)
+	}
+	if domain.PRStatus(status) != domain.StatusOpen {
+		return nil, repository.ErrPRStatusChanged
+	}
+
+	// Re-check merge policy on locked reviews
+	rows, err := tx.Query(ctx, `SELECT reviewer_id, state FROM pr_reviewers WHERE pr_id = $1 FOR UPDATE`, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock reviewers: %w", err)
+	}
+	for rows.Next() {
+		var reviewer domain.Reviewer
+		if err = rows.Scan(&reviewer.UserID, &reviewer.State); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan reviewer: %w", err)
+		}
+		pr.Reviewers = append(pr.Reviewers, reviewer)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reviewers: %w", err)
+	}
+
+	if reasons := pr.MergePolicyViolations(settings); len(reasons) > 0 {
+		if override == nil {
+			return nil, domain.NewErrorWithDetails(domain.ErrCodeMergeBlocked, "merge policy is not satisfied", reasons)
+		}
+		recorded = override
+	}
+
+	var overrideBy, overrideReason *string
+	if recorded != nil {
+		overrideBy, overrideReason = &recorded.ActorID, &recorded.Reason
+	}
+
 	// Update merge status and date
+	query = `
+		UPDATE pull_requests 
+		SET status = $1, merged_at = COALESCE(merged_at, $2), merge_override_by = $4, merge_override_reason = $5, 
+		    merged_by = NULLIF($6, '')
+		WHERE pull_request_id = $3
+`
+	_, err = tx.Exec(ctx, query, string(domain.StatusMerged), mergedAt, prID, overrideBy, overrideReason, mergedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update merge status and date: %w", err)
+	}
+	return recorded, nil
+}
+
+func (r *prRepository) UpdateStatus(
+	ctx context.Context,
+	prID string,
+	from, to domain.PRStatus,
+	newReviewers []domain.Reviewer,
+	removedReviewerIDs []string,
+	audit domain.Audit,
+) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	// Update status only if it was not changed concurrently
 	query := `
 		UPDATE pull_requests 
-		SET status = $1, merged_at = COALESCE(merged_at, $2)
-		WHERE pull_request_id = $3 AND status != $1
+		SET status = $1, closed_at = CASE WHEN $1 = $4 THEN now() END
+		WHERE pull_request_id = $2 AND status = $3
 `
-	res, err := r.db.Exec(ctx, query, string(domain.StatusMerged), mergedAt, prID)
+	res, err := tx.Exec(ctx, query, string(to), prID, string(from), string(domain.StatusClosed))
 	if err != nil {
-		return fmt.Errorf("failed to update merge status and date: %w", err)
+		return fmt.Errorf("failed to update PR status: %w", err)
 	}
 
 	if res.RowsAffected() == 0 {
-		// Check pr existing
-		exists, err := r.Exists(ctx, prID)
+		var exists bool
+		err = tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM pull_requests WHERE pull_request_id = $1)`, prID).Scan(&exists)
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to check pull request existence: %w", err)
 		}
 		if !exists {
 			return repository.ErrPRNotFound
 		}
+		return repository.ErrPRStatusChanged
+	}
+
+	// Unassign reviewers, they must not have been changed concurrently
+	for _, reviewerID := range removedReviewerIDs {
+		res, err = tx.Exec(ctx, `DELETE FROM pr_reviewers WHERE pr_id = $1 AND reviewer_id = $2`, prID, reviewerID)
+		if err != nil {
+			return fmt.Errorf("failed to unassign reviewer: %w", err)
+		}
+		if res.RowsAffected() == 0 {
+			return repository.ErrReviewerChanged
+		}
+
+		err = insertReviewerEvent(ctx, tx, domain.ReviewerEvent{
+			PRID:       prID,
+			Type:       domain.EventUnassign,
+			ReviewerID: reviewerID,
+			ActorID:    audit.ActorID,
+			Reason:     audit.Reason,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Assign reviewers
+	prReviewersQuery := `
+		INSERT INTO pr_reviewers (pr_id, reviewer_id, is_fallback, assigned_at) 
+		VALUES ($1, $2, $3, $4)
+`
+	for _, reviewer := range newReviewers {
+		_, err = tx.Exec(ctx, prReviewersQuery, prID, reviewer.UserID, reviewer.IsFallback, reviewer.AssignedAt)
+		if err != nil {
+			return fmt.Errorf("failed to assign reviewer: %w", err)
+		}
+
+		err = insertReviewerEvent(ctx, tx, domain.ReviewerEvent{
+			PRID:       prID,
+			Type:       domain.EventAssign,
+			ReviewerID: reviewer.UserID,
+			ActorID:    audit.ActorID,
+			Reason:     audit.Reason,
+		})
+		if err != nil {
+			return err
+		}
 	}
+
 	return nil
 }
 
+const prColumns = `
+		pull_request_id, pull_request_name, author_id, status, created_at, merged_at, COALESCE(merged_by, ''), closed_at,
+		required_approvals, merge_override_by, merge_override_reason`
+
 func (r *prRepository) GetByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
-	var pr domain.PullRequest
-	query := `
-		SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at 
-		FROM pull_requests
-		WHERE pull_request_id = $1
-`
-	err := r.db.QueryRow(ctx, query, prID).Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt)
+	query := `SELECT ` + prColumns + ` FROM pull_requests WHERE pull_request_id = $1`
+	pr, err := scanPR(r.db.QueryRow(ctx, query, prID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, repository.ErrPRNotFound
@@ -104,15 +276,142 @@ func (r *prRepository) GetByID(ctx context.Context, prID string) (*domain.PullRe
 	}
 
 	// Get reviewers
-	reviewers, err := r.GetReviewersIDs(ctx, prID)
+	reviewers, err := r.getReviewers(ctx, prID)
 	if err != nil {
 		return nil, err
 	}
-	pr.AssignedReviewers = reviewers
+	setReviewers(pr, reviewers)
+
+	return pr, nil
+}
+
+func (r *prRepository) GetByIDs(ctx context.Context, prIDs []string) ([]domain.PullRequest, error) {
+	query := `SELECT ` + prColumns + ` FROM pull_requests WHERE pull_request_id = ANY($1) ORDER BY pull_request_id`
+	rows, err := r.db.Query(ctx, query, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull requests by IDs: %w", err)
+	}
+	defer rows.Close()
+
+	prs := make([]domain.PullRequest, 0, len(prIDs))
+	for rows.Next() {
+		pr, err := scanPR(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pull request: %w", err)
+		}
+		prs = append(prs, *pr)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating pull requests: %w", err)
+	}
+
+	// Get reviewers of all PRs at once
+	reviewersQuery := `
+		SELECT pr_id, reviewer_id, state, is_fallback, assigned_at, reviewed_at 
+		FROM pr_reviewers 
+		WHERE pr_id = ANY($1)
+		ORDER BY pr_id, assigned_at, reviewer_id
+`
+	reviewerRows, err := r.db.Query(ctx, reviewersQuery, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewers: %w", err)
+	}
+	defer reviewerRows.Close()
+
+	reviewersByPR := make(map[string][]domain.Reviewer, len(prs))
+	for reviewerRows.Next() {
+		var (
+			prID     string
+			reviewer domain.Reviewer
+		)
+		err := reviewerRows.Scan(
+			&prID, &reviewer.UserID, &reviewer.State, &reviewer.IsFallback, &reviewer.AssignedAt, &reviewer.ReviewedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer: %w", err)
+		}
+		reviewersByPR[prID] = append(reviewersByPR[prID], reviewer)
+	}
 
+	if err = reviewerRows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reviewers: %w", err)
+	}
+
+	for i := range prs {
+		reviewers := reviewersByPR[prs[i].ID]
+		if reviewers == nil {
+			reviewers = make([]domain.Reviewer, 0)
+		}
+		setReviewers(&prs[i], reviewers)
+	}
+
+	return prs, nil
+}
+
+func scanPR(row pgx.Row) (*domain.PullRequest, error) {
+	var (
+		pr                         domain.PullRequest
+		overrideBy, overrideReason *string
+	)
+	err := row.Scan(
+		&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.MergedBy, &pr.ClosedAt,
+		&pr.RequiredApprovals, &overrideBy, &overrideReason,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if overrideBy != nil {
+		pr.MergeOverride = &domain.MergeOverride{ActorID: *overrideBy}
+		if overrideReason != nil {
+			pr.MergeOverride.Reason = *overrideReason
+		}
+	}
 	return &pr, nil
 }
 
+// Fill reviewer states and derived reviewer ID lists of PR
+func setReviewers(pr *domain.PullRequest, reviewers []domain.Reviewer) {
+	pr.Reviewers = reviewers
+	pr.AssignedReviewers = make([]string, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewer.UserID)
+		if reviewer.IsFallback {
+			pr.FallbackReviewers = append(pr.FallbackReviewers, reviewer.UserID)
+		}
+	}
+}
+
+func (r *prRepository) getReviewers(ctx context.Context, prID string) ([]domain.Reviewer, error) {
+	query := `
+		SELECT reviewer_id, state, is_fallback, assigned_at, reviewed_at 
+		FROM pr_reviewers 
+		WHERE pr_id = $1
+		ORDER BY assigned_at, reviewer_id
+`
+	rows, err := r.db.Query(ctx, query, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewers: %w", err)
+	}
+	defer rows.Close()
+
+	reviewers := make([]domain.Reviewer, 0)
+	for rows.Next() {
+		var reviewer domain.Reviewer
+		err := rows.Scan(&reviewer.UserID, &reviewer.State, &reviewer.IsFallback, &reviewer.AssignedAt, &reviewer.ReviewedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer: %w", err)
+		}
+		reviewers = append(reviewers, reviewer)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reviewers: %w", err)
+	}
+
+	return reviewers, nil
+}
+
 func (r *prRepository) GetReviewersIDs(ctx context.Context, prID string) ([]string, error) {
 	query := `SELECT reviewer_id FROM pr_reviewers WHERE pr_id = $1`
 	rows, err := r.db.Query(ctx, query, prID)
@@ -138,12 +437,71 @@ func (r *prRepository) GetReviewersIDs(ctx context.Context, prID string) ([]stri
 	return reviewersIDs, nil
 }
 
-func (r *prRepository) ChangeReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error {
+func (r *prRepository) GetOpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	query := `
+		SELECT prr.reviewer_id, COUNT(*)
+		FROM pr_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pr_id
+		WHERE pr.status = $1 AND prr.reviewer_id = ANY($2)
+		GROUP BY prr.reviewer_id
+`
+	rows, err := r.db.Query(ctx, query, string(domain.StatusOpen), userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open review counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int, len(userIDs))
+	for rows.Next() {
+		var (
+			reviewerID string
+			count      int
+		)
+		if err := rows.Scan(&reviewerID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan open review count: %w", err)
+		}
+		counts[reviewerID] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating open review counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+func (r *prRepository) ChangeReviewer(
+	ctx context.Context,
+	prID, oldReviewerID, newReviewerID string,
+	isFallback bool,
+	audit domain.Audit,
+) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	if err = lockOpenPR(ctx, tx, prID); err != nil {
+		return err
+	}
+
 	query := `
 			UPDATE pr_reviewers 
-			SET reviewer_id = $1 
-			WHERE reviewer_id = $2 AND pr_id = $3`
-	res, err := r.db.Exec(ctx, query, newReviewerID, oldReviewerID, prID)
+			SET reviewer_id = $1, is_fallback = $2, state = $5, assigned_at = now(), reviewed_at = NULL 
+			WHERE reviewer_id = $3 AND pr_id = $4`
+	res, err := tx.Exec(ctx, query, newReviewerID, isFallback, oldReviewerID, prID, string(domain.ReviewPending))
 	if err != nil {
 		return fmt.Errorf("failed to update reviewer: %w", err)
 	}
@@ -152,6 +510,82 @@ func (r *prRepository) ChangeReviewer(ctx context.Context, prID, oldReviewerID,
 		return repository.ErrPRNotFound
 	}
 
+	return insertReviewerEvent(ctx, tx, domain.ReviewerEvent{
+		PRID:               prID,
+		Type:               domain.EventReassign,
+		ReviewerID:         newReviewerID,
+		PreviousReviewerID: oldReviewerID,
+		ActorID:            audit.ActorID,
+		Reason:             audit.Reason,
+	})
+}
+
+func (r *prRepository) SetReviewState(
+	ctx context.Context,
+	prID, reviewerID string,
+	state domain.ReviewState,
+	reviewedAt time.Time,
+	comment string,
+) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	if err = lockOpenPR(ctx, tx, prID); err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE pr_reviewers 
+		SET state = $1, reviewed_at = $2 
+		WHERE pr_id = $3 AND reviewer_id = $4
+`
+	res, err := tx.Exec(ctx, query, string(state), reviewedAt, prID, reviewerID)
+	if err != nil {
+		return fmt.Errorf("failed to update review state: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return repository.ErrPRNotFound
+	}
+
+	return insertReviewerEvent(ctx, tx, domain.ReviewerEvent{
+		PRID:        prID,
+		Type:        domain.EventReview,
+		ReviewerID:  reviewerID,
+		ReviewState: state,
+		ActorID:     reviewerID,
+		Reason:      comment,
+	})
+}
+
+// lockOpenPR holds PR status until the end of transaction, so reviewers of not open PR are not changed
+func lockOpenPR(ctx context.Context, tx pgx.Tx, prID string) error {
+	var status string
+	query := `SELECT status FROM pull_requests WHERE pull_request_id = $1 FOR SHARE`
+	err := tx.QueryRow(ctx, query, prID).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrPRNotFound
+		}
+		return fmt.Errorf("failed to lock pull request: %w", err)
+	}
+	if domain.PRStatus(status) != domain.StatusOpen {
+		return repository.ErrPRStatusChanged
+	}
 	return nil
 }
 
@@ -165,15 +599,33 @@ func (r *prRepository) Exists(ctx context.Context, prID string) (bool, error) {
 	return exists, nil
 }
 
-func (r *prRepository) GetReviewerStats(ctx context.Context) ([]domain.ReviewerStat, error) {
+func (r *prRepository) CountOpen(ctx context.Context) (int, error) {
+	var count int
+	query := `SELECT count(*) FROM pull_requests WHERE status = 'OPEN'`
+	err := r.db.QueryRow(ctx, query).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count open pull requests: %w", err)
+	}
+	return count, nil
+}
+
+func (r *prRepository) GetReviewerStats(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerStat, error) {
+	q := &listQuery{}
+	if filter.TeamName != "" {
+		q.where("u.team_name = " + q.arg(filter.TeamName))
+	}
+	bucket := statsWindow(q, filter)
+
 	query := `
-    SELECT pr.reviewer_id, COUNT(*) as assignment_count
-    FROM pr_reviewers pr
-    JOIN users u ON pr.reviewer_id = u.user_id
-    GROUP BY pr.reviewer_id
-    ORDER BY assignment_count DESC
+    SELECT ` + bucket + `, prr.reviewer_id, COUNT(*) as assignment_count
+    FROM pr_reviewers prr
+    JOIN users u ON prr.reviewer_id = u.user_id
+    JOIN pull_requests pr ON pr.pull_request_id = prr.pr_id
+` + q.whereClause() + `
+    GROUP BY 1, prr.reviewer_id
+    ORDER BY 1, assignment_count DESC, prr.reviewer_id
   `
-	rows, err := r.db.Query(ctx, query)
+	rows, err := r.db.Query(ctx, query, q.args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get reviewer assignments stats: %w", err)
 	}
@@ -182,7 +634,7 @@ func (r *prRepository) GetReviewerStats(ctx context.Context) ([]domain.ReviewerS
 	var stats []domain.ReviewerStat
 	for rows.Next() {
 		var stat domain.ReviewerStat
-		err := rows.Scan(&stat.UserID, &stat.AssignedCount)
+		err := rows.Scan(&stat.Bucket, &stat.UserID, &stat.AssignedCount)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan reviewer stats: %w", err)
 		}
@@ -196,9 +648,16 @@ func (r *prRepository) GetReviewerStats(ctx context.Context) ([]domain.ReviewerS
 	return stats, nil
 }
 
-func (r *prRepository) GetRRStats(ctx context.Context) ([]domain.PullRequestStat, error) {
+func (r *prRepository) GetRRStats(ctx context.Context, filter domain.StatsFilter) ([]domain.PullRequestStat, error) {
+	q := &listQuery{}
+	if filter.TeamName != "" {
+		q.where(fmt.Sprintf("pr.author_id IN (SELECT user_id FROM users WHERE team_name = %s)", q.arg(filter.TeamName)))
+	}
+	bucket := statsWindow(q, filter)
+
 	query := `
     SELECT 
+      ` + bucket + `,
       pr.pull_request_id,
       pr.pull_request_name,
       pr.author_id,
@@ -206,10 +665,11 @@ func (r *prRepository) GetRRStats(ctx context.Context) ([]domain.PullRequestStat
       COALESCE(COUNT(prr.reviewer_id), 0) as reviewer_count
     FROM pull_requests pr
     LEFT JOIN pr_reviewers prr ON pr.pull_request_id = prr.pr_id
-    GROUP BY pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
-    ORDER BY pr.pull_request_id
+` + q.whereClause() + `
+    GROUP BY 1, pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
+    ORDER BY 1, pr.pull_request_id
   `
-	rows, err := r.db.Query(ctx, query)
+	rows, err := r.db.Query(ctx, query, q.args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get PR stats: %w", err)
 	}
@@ -218,7 +678,9 @@ func (r *prRepository) GetRRStats(ctx context.Context) ([]domain.PullRequestStat
 	var stats []domain.PullRequestStat
 	for rows.Next() {
 		var stat domain.PullRequestStat
-		err := rows.Scan(&stat.PullRequestID, &stat.PullRequestName, &stat.AuthorID, &stat.Status, &stat.ReviewerCount)
+		err := rows.Scan(
+			&stat.Bucket, &stat.PullRequestID, &stat.PullRequestName, &stat.AuthorID, &stat.Status, &stat.ReviewerCount,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan PR stats: %w", err)
 		}
@@ -232,6 +694,84 @@ func (r *prRepository) GetRRStats(ctx context.Context) ([]domain.PullRequestStat
 	return stats, nil
 }
 
+func (r *prRepository) List(ctx context.Context, filter domain.PRFilter) ([]domain.PullRequestShort, string, error) {
+	q := &listQuery{}
+	if filter.Status != "" {
+		q.where("pr.status = " + q.arg(string(filter.Status)))
+	}
+	if filter.AuthorID != "" {
+		q.where("pr.author_id = " + q.arg(filter.AuthorID))
+	}
+	if filter.ReviewerID != "" {
+		q.where(fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM pr_reviewers prr WHERE prr.pr_id = pr.pull_request_id AND prr.reviewer_id = %s)",
+			q.arg(filter.ReviewerID)))
+	}
+	if filter.TeamName != "" {
+		q.where(fmt.Sprintf("pr.author_id IN (SELECT user_id FROM users WHERE team_name = %s)", q.arg(filter.TeamName)))
+	}
+	if filter.CreatedFrom != nil {
+		q.where("pr.created_at >= " + q.arg(*filter.CreatedFrom))
+	}
+	if filter.CreatedTo != nil {
+		q.where("pr.created_at < " + q.arg(*filter.CreatedTo))
+	}
+
+	sortExpr := "pr.pull_request_id"
+	if filter.SortBy == domain.PRSortCreatedAt {
+		sortExpr = "pr.created_at"
+	}
+	if filter.Cursor != "" {
+		c, err := decodeCursor(filter.Cursor, filter.SortBy)
+		if err != nil {
+			return nil, "", err
+		}
+		var value any = c.Value
+		if filter.SortBy == domain.PRSortCreatedAt {
+			value, err = time.Parse(time.RFC3339Nano, c.Value)
+			if err != nil {
+				return nil, "", repository.ErrInvalidCursor
+			}
+		}
+		q.after(sortExpr, "pr.pull_request_id", filter.Order, value, c.ID)
+	}
+
+	query := `
+		SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at
+		FROM pull_requests pr
+` + q.whereClause() + q.orderClause(sortExpr, "pr.pull_request_id", filter.Order, filter.Limit)
+	rows, err := r.db.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list PRs: %w", err)
+	}
+	defer rows.Close()
+
+	prs := make([]domain.PullRequestShort, 0, filter.Limit)
+	for rows.Next() {
+		var pr domain.PullRequestShort
+		err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan pull request: %w", err)
+		}
+		prs = append(prs, pr)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating pull requests: %w", err)
+	}
+
+	if len(prs) <= filter.Limit {
+		return prs, "", nil
+	}
+	prs = prs[:filter.Limit]
+	last := prs[len(prs)-1]
+	value := last.ID
+	if filter.SortBy == domain.PRSortCreatedAt {
+		value = last.CreatedAt.Format(time.RFC3339Nano)
+	}
+	return prs, encodeCursor(cursor{SortBy: filter.SortBy, Value: value, ID: last.ID}), nil
+}
+
 func isDuplicatePRKeyError(err error) bool {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRRepository_MergeRechecksPolicy(t *testing.T) {
+	ctx := context.Background()
+	pool := newTestPool(t)
+	teamRepo := NewTeamRepository(pool)
+	prRepo := NewPRRepository(pool)
+
+	active := true
+	team := &domain.Team{
+		Name: "team-1",
+		Members: []domain.TeamMember{
+			{ID: "author-1", Name: "Author", IsActive: &active},
+			{ID: "user-2", Name: "Reviewer", IsActive: &active},
+		},
+	}
+	require.NoError(t, teamRepo.CreateWithMembers(ctx, team, domain.Audit{}))
+
+	for _, prID := range []string{"pr-1", "pr-2"} {
+		pr := &domain.PullRequest{
+			ID:                prID,
+			Name:              "PR",
+			AuthorID:          "author-1",
+			Status:            domain.StatusOpen,
+			AssignedReviewers: []string{"user-2"},
+			CreatedAt:         time.Now(),
+		}
+		require.NoError(t, prRepo.Create(ctx, pr, domain.Audit{}))
+		require.NoError(t, prRepo.SetReviewState(ctx, prID, "user-2", domain.ReviewChangesRequested, time.Now(), ""))
+	}
+
+	// Policy is checked against stored reviews, not the caller's snapshot
+	settings := domain.DefaultTeamSettings()
+	_, err := prRepo.Merge(ctx, "pr-1", time.Now(), "author-1", settings, nil)
+	var domainErr *domain.Error
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domain.ErrCodeMergeBlocked, domainErr.Code)
+	assert.Equal(t, []string{"changes requested by user-2"}, domainErr.Details)
+
+	pr, err := prRepo.GetByID(ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusOpen, pr.Status)
+
+	override := &domain.MergeOverride{ActorID: "admin", Reason: "hotfix"}
+	recorded, err := prRepo.Merge(ctx, "pr-1", time.Now(), "admin", settings, override)
+	require.NoError(t, err)
+	assert.Equal(t, override, recorded)
+
+	// Override of satisfied policy is not recorded
+	settings.BlockOnChangesRequested = false
+	recorded, err = prRepo.Merge(ctx, "pr-2", time.Now(), "admin", settings, override)
+	require.NoError(t, err)
+	assert.Nil(t, recorded)
+
+	pr, err = prRepo.GetByID(ctx, "pr-2")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusMerged, pr.Status)
+	assert.Nil(t, pr.MergeOverride)
+
+	_, err = prRepo.Merge(ctx, "pr-2", time.Now(), "admin", settings, nil)
+	assert.ErrorIs(t, err, repository.ErrPRStatusChanged)
+}
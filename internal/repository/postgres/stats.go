@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"strings"
+)
+
+// Restrict stats query to the filter window and return bucket expression, NULL when stats are not grouped
+func statsWindow(q *listQuery, filter domain.StatsFilter) string {
+	column := "pr.created_at"
+	if filter.DateField == domain.StatsByMergedAt {
+		column = "pr.merged_at"
+		q.where("pr.merged_at IS NOT NULL")
+	}
+	if filter.From != nil {
+		q.where(column + " >= " + q.arg(*filter.From))
+	}
+	if filter.To != nil {
+		q.where(column + " < " + q.arg(*filter.To))
+	}
+
+	if filter.GroupBy == "" {
+		return "NULL::timestamptz"
+	}
+	return fmt.Sprintf("date_trunc(%s, %s, 'UTC')", q.arg(string(filter.GroupBy)), column)
+}
+
+// Per-PR latencies in seconds: from creation to first review, first approval and merge
+const prLatencyCTE = `
+	WITH pr_latency AS (
+		SELECT pr.pull_request_id,
+		       author.team_name,
+		       EXTRACT(EPOCH FROM (
+		           SELECT min(e.created_at) FROM pr_reviewer_events e
+		           WHERE e.pr_id = pr.pull_request_id AND e.event_type = 'REVIEW'
+		       ) - pr.created_at) AS first_review,
+		       EXTRACT(EPOCH FROM (
+		           SELECT min(e.created_at) FROM pr_reviewer_events e
+		           WHERE e.pr_id = pr.pull_request_id AND e.event_type = 'REVIEW' AND e.review_state = 'APPROVED'
+		       ) - pr.created_at) AS approval,
+		       EXTRACT(EPOCH FROM pr.merged_at - pr.created_at) AS merge
+		FROM pull_requests pr
+		JOIN users author ON author.user_id = pr.author_id
+`
+
+func (r *prRepository) GetLatencyStats(ctx context.Context, filter domain.StatsFilter) (*domain.LatencyReport, error) {
+	report := &domain.LatencyReport{
+		Teams:     make([]domain.TeamLatencyStat, 0),
+		Reviewers: make([]domain.ReviewerLatencyStat, 0),
+	}
+
+	// Overall row is the empty grouping set
+	q := latencyQuery(filter)
+	query := prLatencyCTE + q.whereClause() + `
+	)
+	SELECT GROUPING(team_name) = 1, COALESCE(team_name, ''), ` +
+		latencyAggregates("first_review", "approval", "merge") + `
+	FROM pr_latency
+	GROUP BY GROUPING SETS ((), (team_name))
+	ORDER BY 1 DESC, 2
+`
+	rows, err := r.db.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team latency stats: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			overall  bool
+			teamName string
+			stat     domain.LatencyStat
+		)
+		dest := append([]any{&overall, &teamName}, latencyDest(&stat)...)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan team latency stats: %w", err)
+		}
+		if overall {
+			report.Overall = stat
+			continue
+		}
+		report.Teams = append(report.Teams, domain.TeamLatencyStat{TeamName: teamName, LatencyStat: stat})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating team latency stats: %w", err)
+	}
+
+	// Reviewer latencies are measured from the reviewer's assignment
+	q = latencyQuery(filter)
+	query = prLatencyCTE + q.whereClause() + `
+	)
+	SELECT prr.reviewer_id, ` + latencyAggregates(
+		`EXTRACT(EPOCH FROM (
+		     SELECT min(e.created_at) FROM pr_reviewer_events e
+		     WHERE e.pr_id = prr.pr_id AND e.reviewer_id = prr.reviewer_id AND e.event_type = 'REVIEW'
+		 ) - prr.assigned_at)`,
+		`EXTRACT(EPOCH FROM (
+		     SELECT min(e.created_at) FROM pr_reviewer_events e
+		     WHERE e.pr_id = prr.pr_id AND e.reviewer_id = prr.reviewer_id
+		       AND e.event_type = 'REVIEW' AND e.review_state = 'APPROVED'
+		 ) - prr.assigned_at)`,
+		"l.merge",
+	) + `
+	FROM pr_latency l
+	JOIN pr_reviewers prr ON prr.pr_id = l.pull_request_id
+	GROUP BY prr.reviewer_id
+	ORDER BY prr.reviewer_id
+`
+	rows, err = r.db.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewer latency stats: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var stat domain.ReviewerLatencyStat
+		dest := append([]any{&stat.UserID}, latencyDest(&stat.LatencyStat)...)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan reviewer latency stats: %w", err)
+		}
+		report.Reviewers = append(report.Reviewers, stat)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reviewer latency stats: %w", err)
+	}
+
+	return report, nil
+}
+
+// Window and team filter of latency PRs, team is the author's one
+func latencyQuery(filter domain.StatsFilter) *listQuery {
+	q := &listQuery{}
+	if filter.TeamName != "" {
+		q.where("author.team_name = " + q.arg(filter.TeamName))
+	}
+	statsWindow(q, filter)
+	return q
+}
+
+// Count and p50/p90/p99 of each latency expression, NULL latencies are skipped
+func latencyAggregates(exprs ...string) string {
+	columns := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		columns = append(columns, fmt.Sprintf(`count(%[1]s),
+		percentile_cont(0.5) WITHIN GROUP (ORDER BY %[1]s),
+		percentile_cont(0.9) WITHIN GROUP (ORDER BY %[1]s),
+		percentile_cont(0.99) WITHIN GROUP (ORDER BY %[1]s)`, expr))
+	}
+	return strings.Join(columns, ", ")
+}
+
+func latencyDest(stat *domain.LatencyStat) []any {
+	dest := make([]any, 0, 12)
+	for _, p := range []*domain.LatencyPercentiles{&stat.TimeToFirstReview, &stat.TimeToApproval, &stat.TimeToMerge} {
+		dest = append(dest, &p.Count, &p.P50, &p.P90, &p.P99)
+	}
+	return dest
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/platonso/avito-pr-service/internal/domain"
 	"github.com/platonso/avito-pr-service/internal/repository"
+	"time"
 )
 
 type teamRepository struct {
@@ -18,7 +20,7 @@ func NewTeamRepository(db *pgxpool.Pool) repository.TeamRepository {
 	return &teamRepository{db: db}
 }
 
-func (r *teamRepository) CreateWithMembers(ctx context.Context, team *domain.Team) (err error) {
+func (r *teamRepository) CreateWithMembers(ctx context.Context, team *domain.Team, audit domain.Audit) (err error) {
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -37,8 +39,24 @@ func (r *teamRepository) CreateWithMembers(ctx context.Context, team *domain.Tea
 	}()
 
 	// Create team
-	teamQuery := `INSERT INTO teams(team_name) VALUES ($1)`
-	_, err = tx.Exec(ctx, teamQuery, team.Name)
+	settings := team.Settings
+	if settings == nil {
+		settings = domain.DefaultTeamSettings()
+	}
+	teamQuery := `
+		INSERT INTO teams(
+			team_name, reviewer_strategy, min_reviewers, max_reviewers, required_approvals, block_on_changes_requested
+		) 
+		VALUES ($1, $2, $3, $4, $5, $6)
+`
+	_, err = tx.Exec(ctx, teamQuery,
+		team.Name,
+		settings.ReviewerStrategy,
+		settings.MinReviewers,
+		settings.MaxReviewers,
+		settings.RequiredApprovals,
+		settings.BlockOnChangesRequested,
+	)
 	if err != nil {
 		if isDuplicateTeamKeyError(err) {
 			return repository.ErrTeamAlreadyExists
@@ -46,43 +64,65 @@ func (r *teamRepository) CreateWithMembers(ctx context.Context, team *domain.Tea
 		return fmt.Errorf("failed to create team: %w", err)
 	}
 
-	// Create/update users
-	usersQuery := `
-		INSERT INTO users (user_id, username, team_name, is_active) 
-		VALUES ($1, $2, $3, $4)
-		ON CONFLICT (user_id)
-		DO UPDATE SET
-			username = $2,
-			team_name = $3,
-			is_active = $4
-`
-	for _, member := range team.Members {
-		_, err = tx.Exec(ctx, usersQuery, member.ID, member.Name, team.Name, member.IsActive)
-		if err != nil {
-			return fmt.Errorf("failed to create/update user: %w", err)
-		}
+	// Create fallback teams
+	err = insertFallbackTeams(ctx, tx, team.Name, settings.FallbackTeams)
+	if err != nil {
+		return err
 	}
-	return nil
+
+	// Create users or attach users without a team
+	return upsertMembers(ctx, tx, team.Name, team.Members, audit)
 }
 
 func (r *teamRepository) GetByName(ctx context.Context, teamName string) (*domain.Team, error) {
-	// Check team existence
-	exists, err := r.Exists(ctx, teamName)
+	// Get team settings
+	settings := &domain.TeamSettings{}
+	settingsQuery := `
+		SELECT reviewer_strategy, min_reviewers, max_reviewers, required_approvals, block_on_changes_requested, 
+		       archived_at, rr_cursor
+		FROM teams 
+		WHERE team_name = $1
+`
+	var (
+		archivedAt *time.Time
+		rrCursor   int64
+	)
+	err := r.db.QueryRow(ctx, settingsQuery, teamName).Scan(
+		&settings.ReviewerStrategy,
+		&settings.MinReviewers,
+		&settings.MaxReviewers,
+		&settings.RequiredApprovals,
+		&settings.BlockOnChangesRequested,
+		&archivedAt,
+		&rrCursor,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to check team existence: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("failed to get team settings: %w", err)
 	}
-	if !exists {
-		return nil, repository.ErrTeamNotFound
+
+	settings.FallbackTeams, err = getFallbackTeams(ctx, r.db, teamName)
+	if err != nil {
+		return nil, err
 	}
 
 	team := &domain.Team{
-		Name:    teamName,
-		Members: make([]domain.TeamMember, 0),
+		Name:       teamName,
+		Members:    make([]domain.TeamMember, 0),
+		Settings:   settings,
+		ArchivedAt: archivedAt,
+		RRCursor:   rrCursor,
 	}
 	query := `
-		SELECT user_id, username, is_active 
-		FROM users
-		WHERE team_name = $1
+		SELECT u.user_id, u.username, u.is_active, u.review_weight,
+		       EXISTS (
+		           SELECT 1 FROM user_absences a 
+		           WHERE a.user_id = u.user_id AND a.starts_at <= now() AND a.ends_at > now()
+		       ) AS is_absent
+		FROM users u
+		WHERE u.team_name = $1
 `
 	rows, err := r.db.Query(ctx, query, teamName)
 	if err != nil {
@@ -93,7 +133,7 @@ func (r *teamRepository) GetByName(ctx context.Context, teamName string) (*domai
 	// Get team members
 	for rows.Next() {
 		var tm domain.TeamMember
-		err := rows.Scan(&tm.ID, &tm.Name, &tm.IsActive)
+		err := rows.Scan(&tm.ID, &tm.Name, &tm.IsActive, &tm.ReviewWeight, &tm.IsAbsent)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan team member: %w", err)
 		}
@@ -110,16 +150,362 @@ func (r *teamRepository) GetByName(ctx context.Context, teamName string) (*domai
 func (r *teamRepository) GetByUserID(ctx context.Context, userID string) (*domain.Team, error) {
 	// Get user's team name
 	var teamName string
-	query := `SELECT team_name FROM users WHERE user_id = $1`
+	query := `SELECT COALESCE(team_name, '') FROM users WHERE user_id = $1`
 	err := r.db.QueryRow(ctx, query, userID).Scan(&teamName)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrUserNotFound
+		}
 		return nil, fmt.Errorf("failed to get user's team: %w", err)
 	}
+	if teamName == "" {
+		return nil, repository.ErrTeamNotFound
+	}
 
 	// Get team
 	return r.GetByName(ctx, teamName)
 }
 
+func (r *teamRepository) UpdateSettings(
+	ctx context.Context,
+	teamName string,
+	patch *domain.TeamSettingsPatch,
+) (settings *domain.TeamSettings, err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	// Lock current settings, concurrent patches are applied one after another
+	current := domain.TeamSettings{}
+	selectQuery := `
+		SELECT reviewer_strategy, min_reviewers, max_reviewers, required_approvals, block_on_changes_requested
+		FROM teams
+		WHERE team_name = $1
+		FOR UPDATE
+`
+	err = tx.QueryRow(ctx, selectQuery, teamName).Scan(
+		&current.ReviewerStrategy,
+		&current.MinReviewers,
+		&current.MaxReviewers,
+		&current.RequiredApprovals,
+		&current.BlockOnChangesRequested,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("failed to get team settings: %w", err)
+	}
+	current.FallbackTeams, err = getFallbackTeams(ctx, tx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	settings = patch.Apply(current)
+	if err = settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Update team settings
+	query := `
+		UPDATE teams 
+		SET reviewer_strategy = $1, 
+			min_reviewers = $2, 
+			max_reviewers = $3, 
+			required_approvals = $4, 
+			block_on_changes_requested = $5
+		WHERE team_name = $6
+`
+	_, err = tx.Exec(ctx, query,
+		settings.ReviewerStrategy,
+		settings.MinReviewers,
+		settings.MaxReviewers,
+		settings.RequiredApprovals,
+		settings.BlockOnChangesRequested,
+		teamName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update team settings: %w", err)
+	}
+	if patch.FallbackTeams == nil {
+		return settings, nil
+	}
+
+	// Replace fallback teams
+	_, err = tx.Exec(ctx, `DELETE FROM team_fallbacks WHERE team_name = $1`, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete fallback teams: %w", err)
+	}
+
+	if err = insertFallbackTeams(ctx, tx, teamName, settings.FallbackTeams); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (r *teamRepository) DeactivateMembers(
+	ctx context.Context,
+	teamName string,
+	userIDs []string,
+	reassignments []domain.ReviewReassignment,
+	audit domain.Audit,
+) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	// Deactivate members
+	res, err := tx.Exec(ctx,
+		`UPDATE users SET is_active = false WHERE team_name = $1 AND user_id = ANY($2)`,
+		teamName, userIDs)
+	if err != nil {
+		return fmt.Errorf("failed to deactivate users: %w", err)
+	}
+	if res.RowsAffected() != int64(len(userIDs)) {
+		return repository.ErrUserNotFound
+	}
+
+	for _, userID := range userIDs {
+		err = insertUserEvent(ctx, tx, domain.UserEvent{
+			UserID:   userID,
+			Type:     domain.EventDeactivate,
+			TeamName: teamName,
+			ActorID:  audit.ActorID,
+			Reason:   audit.Reason,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return reassignReviewers(ctx, tx, reassignments, audit)
+}
+
+func (r *teamRepository) Archive(ctx context.Context, teamName string, archivedAt time.Time) error {
+	query := `UPDATE teams SET archived_at = $1 WHERE team_name = $2 AND archived_at IS NULL`
+	res, err := r.db.Exec(ctx, query, archivedAt, teamName)
+	if err != nil {
+		return fmt.Errorf("failed to archive team: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return repository.ErrTeamNotFound
+	}
+	return nil
+}
+
+func (r *teamRepository) AdvanceRRCursor(ctx context.Context, teamName string, count int) (int64, error) {
+	// Concurrent assignments get consecutive positions
+	query := `UPDATE teams SET rr_cursor = rr_cursor + $1 WHERE team_name = $2 RETURNING rr_cursor - $1`
+	var cursor int64
+	err := r.db.QueryRow(ctx, query, count, teamName).Scan(&cursor)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrTeamNotFound
+		}
+		return 0, fmt.Errorf("failed to advance round-robin cursor: %w", err)
+	}
+	return cursor, nil
+}
+
+func (r *teamRepository) Delete(ctx context.Context, teamName string) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	// Lock team row so members cannot be added concurrently
+	var name string
+	err = tx.QueryRow(ctx, `SELECT team_name FROM teams WHERE team_name = $1 FOR UPDATE`, teamName).Scan(&name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrTeamNotFound
+		}
+		return fmt.Errorf("failed to get team: %w", err)
+	}
+
+	// Draft and open PRs authored or reviewed by team members block deletion
+	var openPRs int
+	openPRsQuery := `
+		SELECT count(*) 
+		FROM pull_requests pr
+		WHERE pr.status IN ($2, $3)
+		  AND (
+		      pr.author_id IN (SELECT user_id FROM users WHERE team_name = $1)
+		      OR EXISTS (
+		          SELECT 1 FROM pr_reviewers prr 
+		          JOIN users u ON u.user_id = prr.reviewer_id
+		          WHERE prr.pr_id = pr.pull_request_id AND u.team_name = $1
+		      )
+		  )
+`
+	err = tx.QueryRow(ctx, openPRsQuery, teamName, string(domain.StatusDraft), string(domain.StatusOpen)).Scan(&openPRs)
+	if err != nil {
+		return fmt.Errorf("failed to count team open PRs: %w", err)
+	}
+	if openPRs > 0 {
+		return repository.ErrTeamHasOpenPRs
+	}
+
+	var members int
+	err = tx.QueryRow(ctx, `SELECT count(*) FROM users WHERE team_name = $1`, teamName).Scan(&members)
+	if err != nil {
+		return fmt.Errorf("failed to count team members: %w", err)
+	}
+	if members > 0 {
+		return repository.ErrTeamHasMembers
+	}
+
+	// Fallback links of the team and to the team are removed by cascade
+	_, err = tx.Exec(ctx, `DELETE FROM teams WHERE team_name = $1`, teamName)
+	if err != nil {
+		return fmt.Errorf("failed to delete team: %w", err)
+	}
+	return nil
+}
+
+func (r *teamRepository) List(ctx context.Context, filter domain.TeamFilter) ([]domain.TeamSummary, string, error) {
+	q := &listQuery{}
+	if filter.NamePrefix != "" {
+		q.where("t.team_name LIKE " + q.arg(likePattern(filter.NamePrefix)+"%"))
+	}
+	if filter.Archived != nil {
+		q.where(fmt.Sprintf("(t.archived_at IS NOT NULL) = %s", q.arg(*filter.Archived)))
+	}
+	if filter.Cursor != "" {
+		c, err := decodeCursor(filter.Cursor, filter.SortBy)
+		if err != nil {
+			return nil, "", err
+		}
+		q.after("t.team_name", "t.team_name", filter.Order, c.Value, c.ID)
+	}
+
+	query := `
+		SELECT t.team_name, 
+		       count(u.user_id), 
+		       count(u.user_id) FILTER (WHERE u.is_active), 
+		       t.archived_at
+		FROM teams t
+		LEFT JOIN users u ON u.team_name = t.team_name
+` + q.whereClause() +
+		" GROUP BY t.team_name" +
+		q.orderClause("t.team_name", "t.team_name", filter.Order, filter.Limit)
+	rows, err := r.db.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list teams: %w", err)
+	}
+	defer rows.Close()
+
+	teams := make([]domain.TeamSummary, 0, filter.Limit)
+	for rows.Next() {
+		var team domain.TeamSummary
+		err := rows.Scan(&team.Name, &team.MembersCount, &team.ActiveMembers, &team.ArchivedAt)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan team: %w", err)
+		}
+		teams = append(teams, team)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating teams: %w", err)
+	}
+
+	if len(teams) <= filter.Limit {
+		return teams, "", nil
+	}
+	teams = teams[:filter.Limit]
+	last := teams[len(teams)-1]
+	return teams, encodeCursor(cursor{SortBy: filter.SortBy, Value: last.Name, ID: last.Name}), nil
+}
+
+// Pool or transaction to read from
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func getFallbackTeams(ctx context.Context, q querier, teamName string) ([]string, error) {
+	query := `
+		SELECT fallback_team_name 
+		FROM team_fallbacks 
+		WHERE team_name = $1 
+		ORDER BY priority
+`
+	rows, err := q.Query(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fallback teams: %w", err)
+	}
+	defer rows.Close()
+
+	fallbackTeams := make([]string, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan fallback team: %w", err)
+		}
+		fallbackTeams = append(fallbackTeams, name)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating fallback teams: %w", err)
+	}
+
+	return fallbackTeams, nil
+}
+
+func insertFallbackTeams(ctx context.Context, tx pgx.Tx, teamName string, fallbackTeams []string) error {
+	query := `
+		INSERT INTO team_fallbacks (team_name, fallback_team_name, priority) 
+		VALUES ($1, $2, $3)
+`
+	for priority, fallbackTeam := range fallbackTeams {
+		_, err := tx.Exec(ctx, query, teamName, fallbackTeam, priority)
+		if err != nil {
+			if isForeignKeyError(err) {
+				return repository.ErrTeamNotFound
+			}
+			return fmt.Errorf("failed to add fallback team: %w", err)
+		}
+	}
+	return nil
+}
+
 func (r *teamRepository) Exists(ctx context.Context, teamName string) (bool, error) {
 	var exists bool
 	query := `SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)`
@@ -130,6 +516,14 @@ func (r *teamRepository) Exists(ctx context.Context, teamName string) (bool, err
 	return exists, nil
 }
 
+func isForeignKeyError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23503"
+	}
+	return false
+}
+
 func isDuplicateTeamKeyError(err error) bool {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
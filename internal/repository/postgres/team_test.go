@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamRepository_AdvanceRRCursor(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTeamRepository(newTestPool(t))
+
+	active := true
+	team := &domain.Team{
+		Name:     "team-1",
+		Members:  []domain.TeamMember{{ID: "user-1", Name: "Alice", IsActive: &active}},
+		Settings: &domain.TeamSettings{ReviewerStrategy: domain.StrategyRoundRobin},
+	}
+	require.NoError(t, repo.CreateWithMembers(ctx, team, domain.Audit{}))
+
+	cursor, err := repo.AdvanceRRCursor(ctx, "team-1", 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), cursor)
+
+	cursor, err = repo.AdvanceRRCursor(ctx, "team-1", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), cursor)
+
+	stored, err := repo.GetByName(ctx, "team-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stored.RRCursor)
+
+	_, err = repo.AdvanceRRCursor(ctx, "missing", 1)
+	assert.ErrorIs(t, err, repository.ErrTeamNotFound)
+}
+
+func TestTeamRepository_GetByUserID_Missing(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTeamRepository(newTestPool(t))
+
+	_, err := repo.GetByUserID(ctx, "missing")
+	assert.ErrorIs(t, err, repository.ErrUserNotFound)
+}
+
+func TestTeamRepository_UpdateSettings_ConcurrentPatches(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTeamRepository(newTestPool(t))
+
+	active := true
+	for _, name := range []string{"team-1", "team-2"} {
+		team := &domain.Team{
+			Name:    name,
+			Members: []domain.TeamMember{{ID: name + "-user", Name: "User", IsActive: &active}},
+		}
+		require.NoError(t, repo.CreateWithMembers(ctx, team, domain.Audit{}))
+	}
+
+	_, err := repo.UpdateSettings(ctx, "team-1", &domain.TeamSettingsPatch{FallbackTeams: []string{"team-2"}})
+	require.NoError(t, err)
+
+	// Each patch changes its own field, none of them may be lost
+	strategy := domain.StrategyRoundRobin
+	approvals, maxReviewers := 2, 3
+	block := false
+	patches := []*domain.TeamSettingsPatch{
+		{ReviewerStrategy: &strategy},
+		{RequiredApprovals: &approvals},
+		{MaxReviewers: &maxReviewers},
+		{BlockOnChangesRequested: &block},
+	}
+	var wg sync.WaitGroup
+	for _, patch := range patches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := repo.UpdateSettings(ctx, "team-1", patch)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	team, err := repo.GetByName(ctx, "team-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StrategyRoundRobin, team.Settings.ReviewerStrategy)
+	assert.Equal(t, 2, team.Settings.RequiredApprovals)
+	assert.Equal(t, 3, team.Settings.MaxReviewers)
+	assert.False(t, team.Settings.BlockOnChangesRequested)
+	assert.Equal(t, []string{"team-2"}, team.Settings.FallbackTeams)
+
+	// Invalid result is rejected and nothing is stored
+	minReviewers := 4
+	_, err = repo.UpdateSettings(ctx, "team-1", &domain.TeamSettingsPatch{MinReviewers: &minReviewers})
+	var domainErr *domain.Error
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, domain.ErrCodeBadRequest, domainErr.Code)
+
+	team, err = repo.GetByName(ctx, "team-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultMinReviewers, team.Settings.MinReviewers)
+
+	_, err = repo.UpdateSettings(ctx, "missing", &domain.TeamSettingsPatch{})
+	assert.ErrorIs(t, err, repository.ErrTeamNotFound)
+}
@@ -18,21 +18,97 @@ func NewUserRepository(db *pgxpool.Pool) repository.UserRepository {
 	return &userRepository{db: db}
 }
 
-func (r *userRepository) SetIsActive(ctx context.Context, userID string, isActive bool) error {
-	query := `UPDATE users SET is_active = $1 WHERE user_id = $2`
-	res, err := r.db.Exec(ctx, query, isActive, userID)
+func (r *userRepository) SetIsActive(ctx context.Context, userID string, isActive bool, audit domain.Audit) (err error) {
+	tx, err := r.db.Begin(ctx)
 	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	var teamName string
+	query := `UPDATE users SET is_active = $1 WHERE user_id = $2 RETURNING COALESCE(team_name, '')`
+	err = tx.QueryRow(ctx, query, isActive, userID).Scan(&teamName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrUserNotFound
+		}
 		return fmt.Errorf("failed to update user status: %w", err)
 	}
-	if res.RowsAffected() == 0 {
-		return repository.ErrUserNotFound
+
+	eventType := domain.EventDeactivate
+	if isActive {
+		eventType = domain.EventActivate
 	}
-	return nil
+	return insertUserEvent(ctx, tx, domain.UserEvent{
+		UserID:   userID,
+		Type:     eventType,
+		TeamName: teamName,
+		ActorID:  audit.ActorID,
+		Reason:   audit.Reason,
+	})
+}
+
+func (r *userRepository) Deactivate(
+	ctx context.Context,
+	userID string,
+	reassignments []domain.ReviewReassignment,
+	audit domain.Audit,
+) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		var e error
+		if err == nil {
+			e = tx.Commit(ctx)
+		} else {
+			e = tx.Rollback(ctx)
+		}
+
+		if err == nil && e != nil {
+			err = fmt.Errorf("finishing transaction: %w", e)
+		}
+	}()
+
+	var teamName string
+	query := `UPDATE users SET is_active = false WHERE user_id = $1 RETURNING COALESCE(team_name, '')`
+	err = tx.QueryRow(ctx, query, userID).Scan(&teamName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to update user status: %w", err)
+	}
+
+	err = insertUserEvent(ctx, tx, domain.UserEvent{
+		UserID:   userID,
+		Type:     domain.EventDeactivate,
+		TeamName: teamName,
+		ActorID:  audit.ActorID,
+		Reason:   audit.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	return reassignReviewers(ctx, tx, reassignments, audit)
 }
 
 func (r *userRepository) GetByID(ctx context.Context, userID string) (*domain.User, error) {
 	var user domain.User
-	query := `SELECT user_id, username, team_name, is_active FROM users WHERE user_id = $1`
+	query := `SELECT user_id, username, COALESCE(team_name, ''), is_active FROM users WHERE user_id = $1`
 	err := r.db.QueryRow(ctx, query, userID).Scan(&user.ID, &user.Name, &user.TeamName, &user.IsActive)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -45,7 +121,7 @@ func (r *userRepository) GetByID(ctx context.Context, userID string) (*domain.Us
 
 func (r *userRepository) GetPRsByUserID(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
 	query := `
-	SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status 
+	SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at 
 	FROM pull_requests pr
 	JOIN pr_reviewers prr ON pr.pull_request_id = prr.pr_id
 	WHERE prr.reviewer_id = $1
@@ -60,7 +136,7 @@ func (r *userRepository) GetPRsByUserID(ctx context.Context, userID string) ([]d
 	prs := make([]domain.PullRequestShort, 0)
 	for rows.Next() {
 		var pr domain.PullRequestShort
-		err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status)
+		err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan pull request: %w", err)
 		}
@@ -73,3 +149,84 @@ func (r *userRepository) GetPRsByUserID(ctx context.Context, userID string) ([]d
 
 	return prs, nil
 }
+
+func (r *userRepository) GetWorkload(ctx context.Context, userID string) (*domain.UserWorkload, error) {
+	var workload domain.UserWorkload
+	query := `
+		SELECT
+		    (SELECT count(*) FROM pr_reviewers prr 
+		     JOIN pull_requests pr ON pr.pull_request_id = prr.pr_id 
+		     WHERE prr.reviewer_id = $1 AND pr.status = $2),
+		    (SELECT count(*) FROM pull_requests WHERE author_id = $1 AND status = $2),
+		    (SELECT count(*) FROM pull_requests WHERE author_id = $1 AND status = $3)
+`
+	err := r.db.QueryRow(ctx, query, userID, string(domain.StatusOpen), string(domain.StatusMerged)).Scan(
+		&workload.OpenReviews,
+		&workload.AuthoredOpenPRs,
+		&workload.MergedPRs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user workload: %w", err)
+	}
+	return &workload, nil
+}
+
+func (r *userRepository) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, string, error) {
+	q := &listQuery{}
+	if filter.TeamName != "" {
+		q.where("u.team_name = " + q.arg(filter.TeamName))
+	}
+	if filter.IsActive != nil {
+		q.where("u.is_active = " + q.arg(*filter.IsActive))
+	}
+	if filter.Search != "" {
+		pattern := q.arg("%" + likePattern(filter.Search) + "%")
+		q.where(fmt.Sprintf("(u.user_id ILIKE %s OR u.username ILIKE %s)", pattern, pattern))
+	}
+
+	sortExpr := "u.user_id"
+	if filter.SortBy == domain.UserSortName {
+		sortExpr = "u.username"
+	}
+	if filter.Cursor != "" {
+		c, err := decodeCursor(filter.Cursor, filter.SortBy)
+		if err != nil {
+			return nil, "", err
+		}
+		q.after(sortExpr, "u.user_id", filter.Order, c.Value, c.ID)
+	}
+
+	query := `SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active FROM users u` +
+		q.whereClause() +
+		q.orderClause(sortExpr, "u.user_id", filter.Order, filter.Limit)
+	rows, err := r.db.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0, filter.Limit)
+	for rows.Next() {
+		var user domain.User
+		err := rows.Scan(&user.ID, &user.Name, &user.TeamName, &user.IsActive)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating users: %w", err)
+	}
+
+	if len(users) <= filter.Limit {
+		return users, "", nil
+	}
+	users = users[:filter.Limit]
+	last := users[len(users)-1]
+	value := last.ID
+	if filter.SortBy == domain.UserSortName {
+		value = last.Name
+	}
+	return users, encodeCursor(cursor{SortBy: filter.SortBy, Value: value, ID: last.ID}), nil
+}
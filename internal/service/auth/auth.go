@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"log/slog"
+	"time"
+)
+
+// Prefix of issued keys, makes leaked keys easy to find with secret scanners
+const keyPrefix = "prs_"
+
+// ID of the key configured through environment, it is not stored in the database
+const bootstrapKeyID = "bootstrap"
+
+// Bootstrap key grants admin access, so it must be hard to guess
+const minBootstrapKeyLength = 32
+
+// Publicly known keys from old examples, never accepted as bootstrap key
+var knownBootstrapKeys = []string{"local-admin-key"}
+
+type Service struct {
+	keyRepo       repository.APIKeyRepository
+	tokens        *TokenVerifier
+	bootstrapHash []byte
+	log           *slog.Logger
+}
+
+// ValidateBootstrapKey rejects bootstrap key that is too short or publicly known, empty key disables it
+func ValidateBootstrapKey(key string) error {
+	if key == "" {
+		return nil
+	}
+	for _, known := range knownBootstrapKeys {
+		if key == known {
+			return errors.New("bootstrap API key is a publicly known default")
+		}
+	}
+	if len(key) < minBootstrapKeyLength {
+		return fmt.Errorf("bootstrap API key must be at least %d characters", minBootstrapKeyLength)
+	}
+	return nil
+}
+
+// NewService creates auth service. Nil tokens disables JWT authentication,
+// non-empty bootstrapKey is accepted as admin key to issue the first keys
+func NewService(
+	keyRepo repository.APIKeyRepository,
+	tokens *TokenVerifier,
+	bootstrapKey string,
+	log *slog.Logger,
+) *Service {
+	s := &Service{
+		keyRepo: keyRepo,
+		tokens:  tokens,
+		log:     log,
+	}
+	if bootstrapKey != "" {
+		s.bootstrapHash = hashKey(bootstrapKey)
+	}
+	return s
+}
+
+// Authenticate resolves caller by JWT or plain API key, invalid tokens, unknown and revoked keys are rejected
+func (s *Service) Authenticate(ctx context.Context, rawKey string) (*domain.Caller, error) {
+	if rawKey == "" {
+		return nil, domain.NewError(domain.ErrCodeUnauthorized, "API key or token is required")
+	}
+
+	hash := hashKey(rawKey)
+	if s.bootstrapHash != nil && subtle.ConstantTimeCompare(hash, s.bootstrapHash) == 1 {
+		return &domain.Caller{Role: domain.RoleAdmin, KeyID: bootstrapKeyID}, nil
+	}
+
+	if s.tokens != nil && looksLikeJWT(rawKey) {
+		caller, err := s.tokens.Verify(rawKey)
+		if err != nil {
+			s.log.Warn("invalid token", slog.String("error", err.Error()))
+			return nil, domain.NewError(domain.ErrCodeUnauthorized, "invalid token")
+		}
+		return caller, nil
+	}
+
+	key, err := s.keyRepo.GetByHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, repository.ErrAPIKeyNotFound) {
+			s.log.Warn("unknown API key")
+			return nil, domain.NewError(domain.ErrCodeUnauthorized, "invalid API key")
+		}
+		s.log.Error("failed to get API key", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	if key.RevokedAt != nil {
+		s.log.Warn("revoked API key", slog.String("key_id", key.ID))
+		return nil, domain.NewError(domain.ErrCodeUnauthorized, "invalid API key")
+	}
+
+	return &domain.Caller{Role: key.Role, KeyID: key.ID}, nil
+}
+
+// CreateKey issues a new key, the plain value is returned only here
+func (s *Service) CreateKey(ctx context.Context, name string, role domain.Role) (*domain.APIKey, string, error) {
+	if !role.IsValid() {
+		return nil, "", domain.NewError(domain.ErrCodeBadRequest, "role must be admin, service or read_only")
+	}
+
+	id, err := randomString(8, hex.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomString(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+	rawKey := keyPrefix + secret
+
+	key := &domain.APIKey{
+		ID:   id,
+		Name: name,
+		Role: role,
+	}
+	if err = s.keyRepo.Create(ctx, key, hashKey(rawKey)); err != nil {
+		s.log.Error("failed to create API key", slog.String("error", err.Error()))
+		return nil, "", fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	s.log.Info("API key created",
+		slog.String("key_id", key.ID),
+		slog.String("role", string(key.Role)))
+	return key, rawKey, nil
+}
+
+func (s *Service) ListKeys(ctx context.Context) ([]domain.APIKey, error) {
+	keys, err := s.keyRepo.List(ctx)
+	if err != nil {
+		s.log.Error("failed to list API keys", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (s *Service) RevokeKey(ctx context.Context, keyID string) error {
+	err := s.keyRepo.Revoke(ctx, keyID, time.Now())
+	if err != nil {
+		if errors.Is(err, repository.ErrAPIKeyNotFound) {
+			s.log.Warn("API key not found", slog.String("key_id", keyID))
+			return domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		s.log.Error("failed to revoke API key", slog.String("error", err.Error()))
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	s.log.Info("API key revoked", slog.String("key_id", keyID))
+	return nil
+}
+
+func hashKey(rawKey string) []byte {
+	sum := sha256.Sum256([]byte(rawKey))
+	return sum[:]
+}
+
+func randomString(size int, encode func([]byte) string) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return encode(b), nil
+}
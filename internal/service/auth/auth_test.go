@@ -0,0 +1,341 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type MockAPIKeyRepository struct {
+	CreateFunc    func(ctx context.Context, key *domain.APIKey, keyHash []byte) error
+	GetByHashFunc func(ctx context.Context, keyHash []byte) (*domain.APIKey, error)
+	ListFunc      func(ctx context.Context) ([]domain.APIKey, error)
+	RevokeFunc    func(ctx context.Context, keyID string, revokedAt time.Time) error
+}
+
+func (m *MockAPIKeyRepository) Create(ctx context.Context, key *domain.APIKey, keyHash []byte) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, key, keyHash)
+	}
+	return nil
+}
+
+func (m *MockAPIKeyRepository) GetByHash(ctx context.Context, keyHash []byte) (*domain.APIKey, error) {
+	if m.GetByHashFunc != nil {
+		return m.GetByHashFunc(ctx, keyHash)
+	}
+	return nil, repository.ErrAPIKeyNotFound
+}
+
+func (m *MockAPIKeyRepository) List(ctx context.Context) ([]domain.APIKey, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockAPIKeyRepository) Revoke(ctx context.Context, keyID string, revokedAt time.Time) error {
+	if m.RevokeFunc != nil {
+		return m.RevokeFunc(ctx, keyID, revokedAt)
+	}
+	return nil
+}
+
+func getTestLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+}
+
+func TestService_CreateKeyAndAuthenticate(t *testing.T) {
+	stored := make(map[string]*domain.APIKey)
+	repo := &MockAPIKeyRepository{
+		CreateFunc: func(ctx context.Context, key *domain.APIKey, keyHash []byte) error {
+			stored[string(keyHash)] = key
+			return nil
+		},
+		GetByHashFunc: func(ctx context.Context, keyHash []byte) (*domain.APIKey, error) {
+			if key, ok := stored[string(keyHash)]; ok {
+				return key, nil
+			}
+			return nil, repository.ErrAPIKeyNotFound
+		},
+	}
+	service := NewService(repo, nil, "", getTestLogger())
+
+	key, rawKey, err := service.CreateKey(context.Background(), "ci", domain.RoleService)
+	require.NoError(t, err)
+	assert.NotEmpty(t, key.ID)
+	assert.Equal(t, domain.RoleService, key.Role)
+	assert.True(t, len(rawKey) > len(keyPrefix))
+
+	// Plain key is never stored
+	for hash := range stored {
+		assert.NotEqual(t, rawKey, hash)
+	}
+
+	got, err := service.Authenticate(context.Background(), rawKey)
+	require.NoError(t, err)
+	assert.Equal(t, key.ID, got.KeyID)
+	assert.Empty(t, got.UserID)
+
+	_, err = service.Authenticate(context.Background(), rawKey+"x")
+	assertErrorCode(t, err, domain.ErrCodeUnauthorized)
+
+	_, _, err = service.CreateKey(context.Background(), "ci", domain.Role("owner"))
+	assertErrorCode(t, err, domain.ErrCodeBadRequest)
+}
+
+func TestValidateBootstrapKey(t *testing.T) {
+	assert.NoError(t, ValidateBootstrapKey(""))
+	assert.NoError(t, ValidateBootstrapKey(strings.Repeat("k", minBootstrapKeyLength)))
+	assert.Error(t, ValidateBootstrapKey("short-key"))
+	assert.Error(t, ValidateBootstrapKey("local-admin-key"))
+}
+
+func TestService_Authenticate(t *testing.T) {
+	revokedAt := time.Now()
+	tests := []struct {
+		name         string
+		bootstrapKey string
+		rawKey       string
+		repoKey      *domain.APIKey
+		repoErr      error
+		wantRole     domain.Role
+		wantCode     domain.ErrorCode
+		wantErr      bool
+	}{
+		{
+			name:     "missing key",
+			rawKey:   "",
+			wantCode: domain.ErrCodeUnauthorized,
+		},
+		{
+			name:         "bootstrap key",
+			bootstrapKey: strings.Repeat("s", minBootstrapKeyLength),
+			rawKey:       strings.Repeat("s", minBootstrapKeyLength),
+			wantRole:     domain.RoleAdmin,
+		},
+		{
+			name:     "active key",
+			rawKey:   "prs_key",
+			repoKey:  &domain.APIKey{ID: "k1", Role: domain.RoleReadOnly},
+			wantRole: domain.RoleReadOnly,
+		},
+		{
+			name:     "revoked key",
+			rawKey:   "prs_key",
+			repoKey:  &domain.APIKey{ID: "k1", Role: domain.RoleAdmin, RevokedAt: &revokedAt},
+			wantCode: domain.ErrCodeUnauthorized,
+		},
+		{
+			name:     "unknown key",
+			rawKey:   "prs_key",
+			repoErr:  repository.ErrAPIKeyNotFound,
+			wantCode: domain.ErrCodeUnauthorized,
+		},
+		{
+			name:    "repository error",
+			rawKey:  "prs_key",
+			repoErr: errors.New("connection refused"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockAPIKeyRepository{
+				GetByHashFunc: func(ctx context.Context, keyHash []byte) (*domain.APIKey, error) {
+					return tt.repoKey, tt.repoErr
+				},
+			}
+			service := NewService(repo, nil, tt.bootstrapKey, getTestLogger())
+
+			caller, err := service.Authenticate(context.Background(), tt.rawKey)
+			if tt.wantCode != "" {
+				assertErrorCode(t, err, tt.wantCode)
+				return
+			}
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRole, caller.Role)
+		})
+	}
+}
+
+func TestService_AuthenticateToken(t *testing.T) {
+	const secret = "jwt-secret"
+	tokens, err := NewTokenVerifier(TokenConfig{HMACSecret: secret, Issuer: "sso"})
+	require.NoError(t, err)
+	service := NewService(&MockAPIKeyRepository{}, tokens, "", getTestLogger())
+
+	sign := func(claims jwt.MapClaims, key string) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(key))
+		require.NoError(t, err)
+		return token
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name     string
+		token    string
+		wantUser string
+		wantRole domain.Role
+	}{
+		{
+			name:     "user token defaults to service role",
+			token:    sign(jwt.MapClaims{"sub": "u1", "iss": "sso", "exp": exp}, secret),
+			wantUser: "u1",
+			wantRole: domain.RoleService,
+		},
+		{
+			name:     "admin role claim",
+			token:    sign(jwt.MapClaims{"sub": "u2", "iss": "sso", "exp": exp, "role": "admin"}, secret),
+			wantUser: "u2",
+			wantRole: domain.RoleAdmin,
+		},
+		{
+			name:  "wrong secret",
+			token: sign(jwt.MapClaims{"sub": "u1", "iss": "sso", "exp": exp}, "other"),
+		},
+		{
+			name:  "expired",
+			token: sign(jwt.MapClaims{"sub": "u1", "iss": "sso", "exp": time.Now().Add(-time.Minute).Unix()}, secret),
+		},
+		{
+			name:  "missing expiration",
+			token: sign(jwt.MapClaims{"sub": "u1", "iss": "sso"}, secret),
+		},
+		{
+			name:  "wrong issuer",
+			token: sign(jwt.MapClaims{"sub": "u1", "iss": "other", "exp": exp}, secret),
+		},
+		{
+			name:  "missing subject",
+			token: sign(jwt.MapClaims{"iss": "sso", "exp": exp}, secret),
+		},
+		{
+			name:  "unknown role",
+			token: sign(jwt.MapClaims{"sub": "u1", "iss": "sso", "exp": exp, "role": "owner"}, secret),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller, err := service.Authenticate(context.Background(), tt.token)
+			if tt.wantUser == "" {
+				assertErrorCode(t, err, domain.ErrCodeUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUser, caller.UserID)
+			assert.Equal(t, tt.wantRole, caller.Role)
+		})
+	}
+}
+
+func TestNewTokenVerifier_JWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	encode := func(b []byte) string {
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	jwksJSON := fmt.Sprintf(`{"keys":[{"kty":"RSA","kid":"k1","use":"sig","n":%q,"e":%q}]}`,
+		encode(key.N.Bytes()), encode(big.NewInt(int64(key.E)).Bytes()))
+	require.NoError(t, os.WriteFile(jwksFile, []byte(jwksJSON), 0o600))
+
+	tokens, err := NewTokenVerifier(TokenConfig{JWKSFile: jwksFile})
+	require.NoError(t, err)
+
+	sign := func(kid string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"sub": "u1",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return signed
+	}
+
+	caller, err := tokens.Verify(sign("k1"))
+	require.NoError(t, err)
+	assert.Equal(t, "u1", caller.UserID)
+
+	_, err = tokens.Verify(sign("k2"))
+	assert.Error(t, err)
+
+	// HMAC token signed with the public modulus must not pass as RS256
+	hmacToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "u1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString(key.N.Bytes())
+	require.NoError(t, err)
+	_, err = tokens.Verify(hmacToken)
+	assert.Error(t, err)
+}
+
+func TestNewTokenVerifier_NotConfigured(t *testing.T) {
+	tokens, err := NewTokenVerifier(TokenConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, tokens)
+
+	_, err = NewTokenVerifier(TokenConfig{HMACSecret: "s", JWKSFile: "jwks.json"})
+	assert.Error(t, err)
+}
+
+func TestCaller_CanActAs(t *testing.T) {
+	var anonymous *domain.Caller
+	assert.True(t, anonymous.CanActAs("u1"))
+	assert.True(t, (&domain.Caller{KeyID: "ci", Role: domain.RoleService}).CanActAs("u1"))
+	assert.True(t, (&domain.Caller{UserID: "u1", Role: domain.RoleService}).CanActAs("u1"))
+	assert.False(t, (&domain.Caller{UserID: "u2", Role: domain.RoleService}).CanActAs("u1"))
+	assert.True(t, (&domain.Caller{UserID: "u2", Role: domain.RoleAdmin}).CanActAs("u1"))
+}
+
+func TestRole_Allows(t *testing.T) {
+	assert.True(t, domain.RoleAdmin.Allows(domain.RoleService))
+	assert.True(t, domain.RoleService.Allows(domain.RoleReadOnly))
+	assert.True(t, domain.RoleReadOnly.Allows(domain.RoleReadOnly))
+	assert.False(t, domain.RoleReadOnly.Allows(domain.RoleService))
+	assert.False(t, domain.RoleService.Allows(domain.RoleAdmin))
+	assert.False(t, domain.Role("").Allows(domain.RoleReadOnly))
+}
+
+func TestService_RevokeKey(t *testing.T) {
+	repo := &MockAPIKeyRepository{
+		RevokeFunc: func(ctx context.Context, keyID string, revokedAt time.Time) error {
+			return repository.ErrAPIKeyNotFound
+		},
+	}
+	service := NewService(repo, nil, "", getTestLogger())
+
+	err := service.RevokeKey(context.Background(), "missing")
+	assertErrorCode(t, err, domain.ErrCodeNotFound)
+}
+
+func assertErrorCode(t *testing.T, err error, code domain.ErrorCode) {
+	t.Helper()
+	var domainErr *domain.Error
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, code, domainErr.Code)
+}
@@ -0,0 +1,205 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"math/big"
+	"os"
+	"strings"
+)
+
+// TokenConfig configures JWT validation, tokens are rejected when neither secret nor JWKS file is set
+type TokenConfig struct {
+	HMACSecret string
+	JWKSFile   string
+	Issuer     string
+	Audience   string
+}
+
+type tokenClaims struct {
+	Role domain.Role `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// TokenVerifier validates signed JWTs and resolves the caller from `sub` and `role` claims
+type TokenVerifier struct {
+	parser  *jwt.Parser
+	keyfunc jwt.Keyfunc
+}
+
+// NewTokenVerifier returns nil verifier when JWT authentication is not configured
+func NewTokenVerifier(cfg TokenConfig) (*TokenVerifier, error) {
+	var (
+		methods []string
+		keyfunc jwt.Keyfunc
+	)
+	switch {
+	case cfg.HMACSecret != "" && cfg.JWKSFile != "":
+		return nil, errors.New("either JWT HMAC secret or JWKS file must be set, not both")
+	case cfg.HMACSecret != "":
+		secret := []byte(cfg.HMACSecret)
+		methods = []string{"HS256", "HS384", "HS512"}
+		keyfunc = func(*jwt.Token) (any, error) {
+			return secret, nil
+		}
+	case cfg.JWKSFile != "":
+		keys, err := loadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		methods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+		keyfunc = keys.keyfunc
+	default:
+		return nil, nil
+	}
+
+	opts := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithExpirationRequired()}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	return &TokenVerifier{
+		parser:  jwt.NewParser(opts...),
+		keyfunc: keyfunc,
+	}, nil
+}
+
+// Verify checks signature and registered claims, users without role claim get service role
+func (v *TokenVerifier) Verify(rawToken string) (*domain.Caller, error) {
+	var claims tokenClaims
+	if _, err := v.parser.ParseWithClaims(rawToken, &claims, v.keyfunc); err != nil {
+		return nil, err
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	role := claims.Role
+	if role == "" {
+		role = domain.RoleService
+	}
+	if !role.IsValid() {
+		return nil, fmt.Errorf("unknown role %q", role)
+	}
+
+	return &domain.Caller{UserID: claims.Subject, Role: role}, nil
+}
+
+// Compact JWS has three dot-separated parts, issued API keys contain no dots
+func looksLikeJWT(raw string) bool {
+	return strings.Count(raw, ".") == 2
+}
+
+// Public keys from a local JWKS file by key id
+type jwks map[string]any
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func loadJWKS(path string) (jwks, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS file: %w", err)
+	}
+
+	keys := make(jwks, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWKS key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS file has no signing keys")
+	}
+	return keys, nil
+}
+
+// Pick key by `kid` header, tokens without kid are accepted only for single-key sets
+func (k jwks) keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" && len(k) == 1 {
+		for _, key := range k {
+			return key, nil
+		}
+	}
+	key, ok := k[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return key, nil
+}
+
+func (k jwk) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64url value: %w", err)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
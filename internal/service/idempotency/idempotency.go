@@ -0,0 +1,166 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"log/slog"
+	"time"
+)
+
+// MaxKeyLength bounds Idempotency-Key header, clients usually send UUIDs
+const MaxKeyLength = 255
+
+// Attempts to reserve a key, record may expire or be released between insert and read
+const beginAttempts = 3
+
+type Service struct {
+	repo        repository.IdempotencyRepository
+	ttl         time.Duration
+	lockTimeout time.Duration
+	log         *slog.Logger
+}
+
+func NewService(
+	repo repository.IdempotencyRepository,
+	ttl time.Duration,
+	lockTimeout time.Duration,
+	log *slog.Logger,
+) *Service {
+	return &Service{
+		repo:        repo,
+		ttl:         ttl,
+		lockTimeout: lockTimeout,
+		log:         log,
+	}
+}
+
+// RequestHash identifies request by method, URI and body
+func RequestHash(method, uri string, body []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(method + " " + uri + "\n"))
+	h.Write(body)
+	return h.Sum(nil)
+}
+
+// Begin reserves the key for a new request. It returns the reservation to complete or release, or stored
+// response when the request was already completed, conflict when the key was used for another request
+// or the first request is still running. A retry takes over the key when the first request did not finish
+// within the lock timeout
+func (s *Service) Begin(ctx context.Context, scope, key string, requestHash []byte) (*domain.IdempotencyRecord, error) {
+	if len(key) > MaxKeyLength {
+		return nil, domain.NewError(domain.ErrCodeBadRequest,
+			fmt.Sprintf("Idempotency-Key must be at most %d characters", MaxKeyLength))
+	}
+
+	lockToken, err := newLockToken()
+	if err != nil {
+		s.log.Error("failed to generate idempotency lock token", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	now := time.Now()
+	record := &domain.IdempotencyRecord{
+		Scope:       scope,
+		Key:         key,
+		RequestHash: requestHash,
+		ExpiresAt:   now.Add(s.ttl),
+		LockedUntil: now.Add(s.lockTimeout),
+		LockToken:   lockToken,
+	}
+	for i := 0; i < beginAttempts; i++ {
+		created, err := s.repo.Create(ctx, record)
+		if err != nil {
+			s.log.Error("failed to create idempotency key", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("failed to create idempotency key: %w", err)
+		}
+		if created {
+			return record, nil
+		}
+
+		existing, err := s.repo.Get(ctx, scope, key)
+		if err != nil {
+			if errors.Is(err, repository.ErrIdempotencyKeyNotFound) {
+				continue
+			}
+			s.log.Error("failed to get idempotency key", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+		}
+
+		if !bytes.Equal(existing.RequestHash, requestHash) {
+			s.log.Warn("idempotency key reused with different request", slog.String("idempotency_key", key))
+			return nil, domain.NewError(domain.ErrCodeIdempotencyKeyReused,
+				"Idempotency-Key was already used with a different request")
+		}
+		if existing.IsLockExpired(now) {
+			taken, err := s.repo.TakeOver(ctx, record)
+			if err != nil {
+				s.log.Error("failed to take over idempotency key", slog.String("error", err.Error()))
+				return nil, fmt.Errorf("failed to take over idempotency key: %w", err)
+			}
+			if taken {
+				s.log.Warn("idempotency key lock expired, taken over", slog.String("idempotency_key", key))
+				return record, nil
+			}
+			continue
+		}
+		if !existing.IsCompleted() {
+			s.log.Warn("idempotent request is in progress", slog.String("idempotency_key", key))
+			return nil, domain.NewError(domain.ErrCodeIdempotencyInFlight,
+				"request with this Idempotency-Key is in progress")
+		}
+		return existing, nil
+	}
+
+	return nil, domain.NewError(domain.ErrCodeIdempotencyInFlight, "request with this Idempotency-Key is in progress")
+}
+
+// Complete stores response of the request reserved by Begin, the record carries the reservation lock token
+func (s *Service) Complete(ctx context.Context, record *domain.IdempotencyRecord) {
+	if err := s.repo.Complete(ctx, record); err != nil {
+		if errors.Is(err, repository.ErrIdempotencyLeaseLost) {
+			s.log.Warn("idempotency key was taken over, response is not stored",
+				slog.String("idempotency_key", record.Key))
+			return
+		}
+		s.log.Error("failed to store idempotent response",
+			slog.String("idempotency_key", record.Key),
+			slog.String("error", err.Error()))
+	}
+}
+
+// Release frees the key so the request can be retried, used when it failed without a stable outcome.
+// Reservation taken over by a retry is left in place
+func (s *Service) Release(ctx context.Context, record *domain.IdempotencyRecord) {
+	if err := s.repo.Delete(ctx, record.Scope, record.Key, record.LockToken); err != nil {
+		s.log.Error("failed to release idempotency key",
+			slog.String("idempotency_key", record.Key),
+			slog.String("error", err.Error()))
+	}
+}
+
+func newLockToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate lock token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (s *Service) DeleteExpired(ctx context.Context) error {
+	deleted, err := s.repo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		s.log.Error("failed to delete expired idempotency keys", slog.String("error", err.Error()))
+		return fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	if deleted > 0 {
+		s.log.Info("expired idempotency keys deleted", slog.Int64("count", deleted))
+	}
+	return nil
+}
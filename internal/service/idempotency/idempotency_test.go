@@ -0,0 +1,298 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type MockIdempotencyRepository struct {
+	CreateFunc        func(ctx context.Context, record *domain.IdempotencyRecord) (bool, error)
+	GetFunc           func(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error)
+	TakeOverFunc      func(ctx context.Context, record *domain.IdempotencyRecord) (bool, error)
+	CompleteFunc      func(ctx context.Context, record *domain.IdempotencyRecord) error
+	DeleteFunc        func(ctx context.Context, scope, key, lockToken string) error
+	DeleteExpiredFunc func(ctx context.Context, now time.Time) (int64, error)
+}
+
+func (m *MockIdempotencyRepository) Create(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, record)
+	}
+	return true, nil
+}
+
+func (m *MockIdempotencyRepository) Get(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, scope, key)
+	}
+	return nil, repository.ErrIdempotencyKeyNotFound
+}
+
+func (m *MockIdempotencyRepository) TakeOver(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+	if m.TakeOverFunc != nil {
+		return m.TakeOverFunc(ctx, record)
+	}
+	return false, nil
+}
+
+func (m *MockIdempotencyRepository) Complete(ctx context.Context, record *domain.IdempotencyRecord) error {
+	if m.CompleteFunc != nil {
+		return m.CompleteFunc(ctx, record)
+	}
+	return nil
+}
+
+func (m *MockIdempotencyRepository) Delete(ctx context.Context, scope, key, lockToken string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, scope, key, lockToken)
+	}
+	return nil
+}
+
+func (m *MockIdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	if m.DeleteExpiredFunc != nil {
+		return m.DeleteExpiredFunc(ctx, now)
+	}
+	return 0, nil
+}
+
+func getTestLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
+}
+
+func TestService_Begin(t *testing.T) {
+	hash := RequestHash("POST", "/pullRequest/create", []byte(`{"pull_request_id":"pr-1"}`))
+	otherHash := RequestHash("POST", "/pullRequest/create", []byte(`{"pull_request_id":"pr-2"}`))
+	completed := &domain.IdempotencyRecord{
+		RequestHash: hash,
+		StatusCode:  201,
+		ContentType: "application/json; charset=utf-8",
+		Body:        []byte(`{"pr":{}}`),
+	}
+
+	tests := []struct {
+		name       string
+		key        string
+		existing   *domain.IdempotencyRecord
+		wantReplay bool
+		wantCode   domain.ErrorCode
+	}{
+		{
+			name: "new key",
+			key:  "k1",
+		},
+		{
+			name:       "completed request is replayed",
+			key:        "k1",
+			existing:   completed,
+			wantReplay: true,
+		},
+		{
+			name:     "key reused with different body",
+			key:      "k1",
+			existing: &domain.IdempotencyRecord{RequestHash: otherHash, StatusCode: 201},
+			wantCode: domain.ErrCodeIdempotencyKeyReused,
+		},
+		{
+			name:     "first request in progress",
+			key:      "k1",
+			existing: &domain.IdempotencyRecord{RequestHash: hash, LockedUntil: time.Now().Add(time.Minute)},
+			wantCode: domain.ErrCodeIdempotencyInFlight,
+		},
+		{
+			name:     "key too long",
+			key:      strings.Repeat("k", MaxKeyLength+1),
+			wantCode: domain.ErrCodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockIdempotencyRepository{
+				CreateFunc: func(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+					assert.Equal(t, "user:u1", record.Scope)
+					assert.True(t, record.ExpiresAt.After(time.Now()))
+					assert.NotEmpty(t, record.LockToken)
+					return tt.existing == nil, nil
+				},
+				GetFunc: func(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error) {
+					return tt.existing, nil
+				},
+			}
+			service := NewService(repo, time.Hour, time.Minute, getTestLogger())
+
+			stored, err := service.Begin(context.Background(), "user:u1", tt.key, hash)
+			if tt.wantCode != "" {
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.wantCode, domainErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantReplay {
+				assert.Equal(t, completed, stored)
+			} else {
+				assert.False(t, stored.IsCompleted())
+				assert.NotEmpty(t, stored.LockToken)
+			}
+		})
+	}
+}
+
+func TestService_Begin_RecordReleasedConcurrently(t *testing.T) {
+	attempts := 0
+	repo := &MockIdempotencyRepository{
+		CreateFunc: func(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+			attempts++
+			return attempts > 1, nil
+		},
+		GetFunc: func(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error) {
+			return nil, repository.ErrIdempotencyKeyNotFound
+		},
+	}
+	service := NewService(repo, time.Hour, time.Minute, getTestLogger())
+
+	reserved, err := service.Begin(context.Background(), "key:ci", "k1", []byte("hash"))
+	require.NoError(t, err)
+	assert.False(t, reserved.IsCompleted())
+	assert.Equal(t, 2, attempts)
+}
+
+func TestService_Begin_LockExpired(t *testing.T) {
+	hash := []byte("hash")
+	tests := []struct {
+		name     string
+		taken    bool
+		wantCode domain.ErrorCode
+	}{
+		{
+			name:  "retry takes over expired lock",
+			taken: true,
+		},
+		{
+			name:     "another retry took over first",
+			wantCode: domain.ErrCodeIdempotencyInFlight,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockedUntil := time.Now().Add(-time.Second)
+			repo := &MockIdempotencyRepository{
+				CreateFunc: func(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+					return false, nil
+				},
+				GetFunc: func(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error) {
+					return &domain.IdempotencyRecord{RequestHash: hash, LockedUntil: lockedUntil}, nil
+				},
+				TakeOverFunc: func(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+					assert.True(t, record.LockedUntil.After(time.Now()))
+					assert.True(t, record.ExpiresAt.After(record.LockedUntil))
+					if tt.taken {
+						return true, nil
+					}
+					// Lease of the other retry is live now
+					lockedUntil = record.LockedUntil
+					return false, nil
+				},
+			}
+			service := NewService(repo, time.Hour, time.Minute, getTestLogger())
+
+			stored, err := service.Begin(context.Background(), "key:ci", "k1", hash)
+			if tt.wantCode != "" {
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.wantCode, domainErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.False(t, stored.IsCompleted())
+		})
+	}
+}
+
+// Reservation in the mock is owned by a lock token like in the repository
+func TestService_ReleaseAfterTakeOver(t *testing.T) {
+	hash := []byte("hash")
+	var stored *domain.IdempotencyRecord
+	repo := &MockIdempotencyRepository{
+		CreateFunc: func(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+			if stored != nil {
+				return false, nil
+			}
+			// First request's lease expires right away
+			stored = &domain.IdempotencyRecord{RequestHash: hash, LockToken: record.LockToken}
+			return true, nil
+		},
+		GetFunc: func(ctx context.Context, scope, key string) (*domain.IdempotencyRecord, error) {
+			if stored == nil {
+				return nil, repository.ErrIdempotencyKeyNotFound
+			}
+			return stored, nil
+		},
+		TakeOverFunc: func(ctx context.Context, record *domain.IdempotencyRecord) (bool, error) {
+			stored.LockToken = record.LockToken
+			stored.LockedUntil = record.LockedUntil
+			return true, nil
+		},
+		CompleteFunc: func(ctx context.Context, record *domain.IdempotencyRecord) error {
+			if stored == nil || stored.IsCompleted() || stored.LockToken != record.LockToken {
+				return repository.ErrIdempotencyLeaseLost
+			}
+			stored.StatusCode = record.StatusCode
+			stored.Body = record.Body
+			return nil
+		},
+		DeleteFunc: func(ctx context.Context, scope, key, lockToken string) error {
+			if stored != nil && !stored.IsCompleted() && stored.LockToken == lockToken {
+				stored = nil
+			}
+			return nil
+		},
+	}
+	service := NewService(repo, time.Hour, time.Minute, getTestLogger())
+	ctx := context.Background()
+
+	first, err := service.Begin(ctx, "key:ci", "k1", hash)
+	require.NoError(t, err)
+	retry, err := service.Begin(ctx, "key:ci", "k1", hash)
+	require.NoError(t, err)
+	require.NotEqual(t, first.LockToken, retry.LockToken)
+
+	// Stale first request neither frees nor completes the key taken over by the retry
+	service.Release(ctx, first)
+	require.NotNil(t, stored)
+	assert.Equal(t, retry.LockToken, stored.LockToken)
+
+	_, err = service.Begin(ctx, "key:ci", "k1", hash)
+	var domainErr *domain.Error
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domain.ErrCodeIdempotencyInFlight, domainErr.Code)
+
+	// First request succeeds late
+	first.StatusCode = 200
+	service.Complete(ctx, first)
+	assert.False(t, stored.IsCompleted())
+
+	retry.StatusCode = 201
+	retry.Body = []byte(`{"pr":{}}`)
+	service.Complete(ctx, retry)
+	assert.Equal(t, 201, stored.StatusCode)
+}
+
+func TestRequestHash(t *testing.T) {
+	body := []byte(`{"a":1}`)
+	assert.Equal(t, RequestHash("POST", "/team/add", body), RequestHash("POST", "/team/add", body))
+	assert.NotEqual(t, RequestHash("POST", "/team/add", body), RequestHash("POST", "/users/setIsActive", body))
+	assert.NotEqual(t, RequestHash("POST", "/team/add", body), RequestHash("POST", "/team/add", []byte(`{"a":2}`)))
+}
@@ -0,0 +1,167 @@
+package pr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/metrics"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"log/slog"
+)
+
+// PlanUserReassignments plans moving open reviews of a single user, see PlanReassignments.
+// User without a team only gets candidates from fallback teams of PR authors
+func (s *Service) PlanUserReassignments(ctx context.Context, userID string) (*domain.ReassignmentReport, error) {
+	team, err := s.teamRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrTeamNotFound) {
+			s.log.Error(err.Error())
+			return nil, fmt.Errorf("failed to get user's team: %w", err)
+		}
+		team = &domain.Team{}
+	}
+	return s.PlanReassignments(ctx, team, []string{userID})
+}
+
+// PlanReassignments distributes open reviews of leaving members across the remaining active members of the team,
+// then across fallback teams of PR author's team, as ReassignReviewer does.
+// Nothing is written, reviews without a replacement are reported as failed
+func (s *Service) PlanReassignments(
+	ctx context.Context,
+	team *domain.Team,
+	userIDs []string,
+) (*domain.ReassignmentReport, error) {
+	remaining := activeMembers(team, userIDs...)
+	remainingIDs := make([]string, 0, len(remaining))
+	for _, member := range remaining {
+		remainingIDs = append(remainingIDs, member.ID)
+	}
+
+	loads := map[string]int{}
+	if len(remainingIDs) > 0 && usesLoad(s.strategyFor(team)) {
+		counts, err := s.prRepo.GetOpenReviewCounts(ctx, remainingIDs)
+		if err != nil {
+			s.log.Error(err.Error())
+			return nil, fmt.Errorf("failed to get reviewers load: %w", err)
+		}
+		for id, count := range counts {
+			loads[id] = count
+		}
+	}
+
+	// Plan may still be rejected, round-robin cursors are not stored
+	planned := plannedCursors{}
+
+	report := &domain.ReassignmentReport{
+		Reassigned: []domain.ReviewReassignment{},
+		Failed:     []domain.FailedReassignment{},
+	}
+
+	// Reviewers of PRs including planned changes
+	reviewersByPR := make(map[string][]string)
+	authorTeams := make(map[string]*domain.Team)
+	for _, userID := range userIDs {
+		prs, err := s.userRepo.GetPRsByUserID(ctx, userID)
+		if err != nil {
+			s.log.Error(err.Error())
+			return nil, fmt.Errorf("failed to get user PRs: %w", err)
+		}
+
+		for _, short := range prs {
+			if short.Status != domain.StatusOpen {
+				continue
+			}
+
+			reviewers, ok := reviewersByPR[short.ID]
+			if !ok {
+				pr, err := s.getPR(ctx, short.ID)
+				if err != nil {
+					return nil, err
+				}
+				reviewers = pr.AssignedReviewers
+			}
+
+			available := s.filterOutReviewers(remaining, append([]string{short.AuthorID}, reviewers...))
+			candidates := make([]Candidate, 0, len(available))
+			for _, member := range available {
+				candidates = append(candidates, Candidate{
+					UserID:      member.ID,
+					OpenReviews: loads[member.ID],
+					Weight:      member.ReviewWeight,
+				})
+			}
+
+			selected, err := s.pickReviewers(ctx, team, candidates, 1, planned)
+			if err != nil {
+				return nil, err
+			}
+			if len(selected) == 0 {
+				authorTeam, ok := authorTeams[short.AuthorID]
+				if !ok {
+					authorTeam, err = s.getPlanAuthorTeam(ctx, short.AuthorID)
+					if err != nil {
+						return nil, err
+					}
+					authorTeams[short.AuthorID] = authorTeam
+				}
+				if authorTeam != nil {
+					exclude := append(append([]string{short.AuthorID}, userIDs...), reviewers...)
+					selected, err = s.selectFallbackReviewers(ctx, authorTeam, 1, planned, exclude...)
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+			if len(selected) == 0 {
+				s.log.Warn("no available reviewers for reassignment",
+					slog.String("pr_id", short.ID),
+					slog.String("old_reviewer_id", userID))
+				metrics.NoCandidateErrors.WithLabelValues(metrics.OpBulkReassign).Inc()
+				report.Failed = append(report.Failed, domain.FailedReassignment{
+					PRID:       short.ID,
+					ReviewerID: userID,
+					Code:       domain.ErrCodeNoCandidate,
+					Reason:     "no active replacement candidate in team",
+				})
+				reviewersByPR[short.ID] = reviewers
+				continue
+			}
+
+			newReviewerID := selected[0]
+			loads[newReviewerID]++
+			reviewersByPR[short.ID] = replaceID(reviewers, userID, newReviewerID)
+			report.Reassigned = append(report.Reassigned, domain.ReviewReassignment{
+				PRID:          short.ID,
+				OldReviewerID: userID,
+				NewReviewerID: newReviewerID,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// Author removed from teams has no fallback teams
+func (s *Service) getPlanAuthorTeam(ctx context.Context, authorID string) (*domain.Team, error) {
+	team, err := s.teamRepo.GetByUserID(ctx, authorID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return nil, nil
+		}
+		s.log.Error(err.Error())
+		return nil, fmt.Errorf("failed to get author's team: %w", err)
+	}
+	return team, nil
+}
+
+func replaceID(ids []string, oldID, newID string) []string {
+	replaced := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == oldID {
+			id = newID
+		}
+		replaced = append(replaced, id)
+	}
+	return replaced
+}
@@ -0,0 +1,178 @@
+package pr
+
+import (
+	"context"
+	"testing"
+
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_PlanReassignments(t *testing.T) {
+	active := true
+	team := &domain.Team{
+		Name:     "team-1",
+		Settings: &domain.TeamSettings{ReviewerStrategy: domain.StrategyLeastLoaded},
+		Members: []domain.TeamMember{
+			{ID: "user-1", IsActive: &active},
+			{ID: "user-2", IsActive: &active},
+			{ID: "user-3", IsActive: &active},
+			{ID: "user-4", IsActive: &active},
+		},
+	}
+
+	prs := map[string]*domain.PullRequest{
+		"pr-1": {ID: "pr-1", AuthorID: "user-4", Status: domain.StatusOpen, AssignedReviewers: []string{"user-1", "user-2"}},
+		"pr-2": {ID: "pr-2", AuthorID: "user-3", Status: domain.StatusOpen, AssignedReviewers: []string{"user-1", "user-4"}},
+	}
+	prRepo := &MockPRRepository{
+		GetByIDFunc: func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+			pr := *prs[prID]
+			return &pr, nil
+		},
+		GetOpenReviewCountsFunc: func(ctx context.Context, userIDs []string) (map[string]int, error) {
+			return map[string]int{"user-3": 0, "user-4": 5}, nil
+		},
+	}
+	userRepo := &MockUserRepository{
+		GetPRsByUserIDFunc: func(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
+			switch userID {
+			case "user-1":
+				return []domain.PullRequestShort{
+					{ID: "pr-1", AuthorID: "user-4", Status: domain.StatusOpen},
+					{ID: "pr-2", AuthorID: "user-3", Status: domain.StatusOpen},
+					{ID: "pr-0", AuthorID: "user-4", Status: domain.StatusMerged},
+				}, nil
+			case "user-2":
+				return []domain.PullRequestShort{
+					{ID: "pr-1", AuthorID: "user-4", Status: domain.StatusOpen},
+				}, nil
+			}
+			return nil, nil
+		},
+	}
+
+	service := NewService(prRepo, &MockTeamRepository{}, userRepo, getTestLogger())
+	report, err := service.PlanReassignments(context.Background(), team, []string{"user-1", "user-2"})
+	require.NoError(t, err)
+
+	// pr-1: author user-4 leaves only user-3, second leaving reviewer has no candidate
+	// pr-2: author user-3 and reviewer user-4 leave no candidate
+	assert.Equal(t, []domain.ReviewReassignment{
+		{PRID: "pr-1", OldReviewerID: "user-1", NewReviewerID: "user-3"},
+	}, report.Reassigned)
+	require.Len(t, report.Failed, 2)
+	assert.Equal(t, "pr-2", report.Failed[0].PRID)
+	assert.Equal(t, "user-1", report.Failed[0].ReviewerID)
+	assert.Equal(t, "pr-1", report.Failed[1].PRID)
+	assert.Equal(t, "user-2", report.Failed[1].ReviewerID)
+	assert.Equal(t, domain.ErrCodeNoCandidate, report.Failed[1].Code)
+}
+
+func TestService_PlanReassignments_FallbackTeams(t *testing.T) {
+	active := true
+	team := &domain.Team{
+		Name: "team-1",
+		Members: []domain.TeamMember{
+			{ID: "user-1", IsActive: &active},
+			{ID: "user-2", IsActive: &active},
+		},
+		Settings: &domain.TeamSettings{FallbackTeams: []string{"team-2"}},
+	}
+	fallbackTeam := &domain.Team{
+		Name:    "team-2",
+		Members: []domain.TeamMember{{ID: "user-5", IsActive: &active}},
+	}
+
+	prRepo := &MockPRRepository{
+		GetByIDFunc: func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+			return &domain.PullRequest{
+				ID:                prID,
+				AuthorID:          "user-2",
+				Status:            domain.StatusOpen,
+				AssignedReviewers: []string{"user-1"},
+			}, nil
+		},
+		GetOpenReviewCountsFunc: func(ctx context.Context, userIDs []string) (map[string]int, error) {
+			return map[string]int{}, nil
+		},
+	}
+	teamRepo := &MockTeamRepository{
+		GetByUserIDFunc: func(ctx context.Context, userID string) (*domain.Team, error) {
+			return team, nil
+		},
+		GetByNameFunc: func(ctx context.Context, teamName string) (*domain.Team, error) {
+			return fallbackTeam, nil
+		},
+	}
+	userRepo := &MockUserRepository{
+		GetPRsByUserIDFunc: func(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
+			return []domain.PullRequestShort{{ID: "pr-1", AuthorID: "user-2", Status: domain.StatusOpen}}, nil
+		},
+	}
+
+	service := NewService(prRepo, teamRepo, userRepo, getTestLogger())
+	report, err := service.PlanUserReassignments(context.Background(), "user-1")
+	require.NoError(t, err)
+
+	// Only the author is left in team-1, replacement comes from its fallback team
+	assert.Equal(t, []domain.ReviewReassignment{
+		{PRID: "pr-1", OldReviewerID: "user-1", NewReviewerID: "user-5"},
+	}, report.Reassigned)
+	assert.Empty(t, report.Failed)
+}
+
+func TestService_PlanReassignments_RoundRobinCursorNotStored(t *testing.T) {
+	active := true
+	team := &domain.Team{
+		Name:     "team-1",
+		Settings: &domain.TeamSettings{ReviewerStrategy: domain.StrategyRoundRobin},
+		RRCursor: 1,
+		Members: []domain.TeamMember{
+			{ID: "user-1", IsActive: &active},
+			{ID: "user-2", IsActive: &active},
+			{ID: "user-3", IsActive: &active},
+			{ID: "user-4", IsActive: &active},
+		},
+	}
+
+	prRepo := &MockPRRepository{
+		GetByIDFunc: func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+			return &domain.PullRequest{
+				ID:                prID,
+				AuthorID:          "user-9",
+				Status:            domain.StatusOpen,
+				AssignedReviewers: []string{"user-1"},
+			}, nil
+		},
+		GetOpenReviewCountsFunc: func(ctx context.Context, userIDs []string) (map[string]int, error) {
+			t.Fatal("round-robin does not need reviewers load")
+			return nil, nil
+		},
+	}
+	teamRepo := &MockTeamRepository{
+		AdvanceRRCursorFunc: func(ctx context.Context, teamName string, count int) (int64, error) {
+			t.Fatal("planning must not advance the stored cursor")
+			return 0, nil
+		},
+	}
+	userRepo := &MockUserRepository{
+		GetPRsByUserIDFunc: func(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
+			return []domain.PullRequestShort{
+				{ID: "pr-1", AuthorID: "user-9", Status: domain.StatusOpen},
+				{ID: "pr-2", AuthorID: "user-9", Status: domain.StatusOpen},
+			}, nil
+		},
+	}
+
+	service := NewService(prRepo, teamRepo, userRepo, getTestLogger())
+	report, err := service.PlanReassignments(context.Background(), team, []string{"user-1"})
+	require.NoError(t, err)
+
+	// Rotation over user-2..user-4 starts at the stored cursor and continues within the plan
+	assert.Equal(t, []domain.ReviewReassignment{
+		{PRID: "pr-1", OldReviewerID: "user-1", NewReviewerID: "user-3"},
+		{PRID: "pr-2", OldReviewerID: "user-1", NewReviewerID: "user-4"},
+	}, report.Reassigned)
+}
@@ -0,0 +1,38 @@
+package pr
+
+import (
+	"context"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"log/slog"
+)
+
+// Default reasons recorded in reviewer events
+const (
+	reasonCreated        = "assigned on PR creation"
+	reasonMarkedReady    = "assigned when PR marked ready"
+	reasonReopened       = "assigned on PR reopen"
+	reasonReopenReplaced = "unavailable reviewer replaced on PR reopen"
+	reasonReassigned     = "reassigned on request"
+)
+
+// GetHistory returns reviewer events of PR in chronological order
+func (s *Service) GetHistory(ctx context.Context, prID string) ([]domain.ReviewerEvent, error) {
+	exists, err := s.prRepo.Exists(ctx, prID)
+	if err != nil {
+		s.log.Error(err.Error())
+		return nil, fmt.Errorf("failed to check PR exists: %w", err)
+	}
+	if !exists {
+		s.log.Warn("PR not found", slog.String("pr_id", prID))
+		return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+	}
+
+	events, err := s.prRepo.GetReviewerEvents(ctx, prID)
+	if err != nil {
+		s.log.Error(err.Error())
+		return nil, fmt.Errorf("failed to get PR history: %w", err)
+	}
+
+	return events, nil
+}
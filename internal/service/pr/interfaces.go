@@ -6,7 +6,29 @@ import (
 )
 
 type ServiceInterface interface {
-	CreatePullRequest(ctx context.Context, prID, prName, authorID string) (*domain.PullRequest, error)
-	MergePR(ctx context.Context, prID string) (*domain.PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (*domain.PullRequest, string, error)
+	CreatePullRequest(
+		ctx context.Context,
+		prID, prName, authorID string,
+		draft bool,
+		opts domain.ReviewerOptions,
+	) (*domain.PullRequest, error)
+	MergePR(ctx context.Context, prID string, opts domain.MergeOptions) (*domain.PullRequest, error)
+	ReassignReviewer(
+		ctx context.Context,
+		prID, oldReviewerID string,
+		audit domain.Audit,
+	) (*domain.PullRequest, string, error)
+	MarkReady(ctx context.Context, prID string, opts domain.ReviewerOptions) (*domain.PullRequest, error)
+	ClosePR(ctx context.Context, prID string) (*domain.PullRequest, error)
+	ReopenPR(ctx context.Context, prID string) (*domain.PullRequest, error)
+	SubmitReview(
+		ctx context.Context,
+		prID, reviewerID string,
+		state domain.ReviewState,
+		comment string,
+	) (*domain.PullRequest, error)
+	GetHistory(ctx context.Context, prID string) ([]domain.ReviewerEvent, error)
+	GetPR(ctx context.Context, prID string) (*domain.PullRequest, error)
+	GetPRs(ctx context.Context, prIDs []string) ([]domain.PullRequest, []string, error)
+	ListPRs(ctx context.Context, filter domain.PRFilter) ([]domain.PullRequestShort, string, error)
 }
@@ -0,0 +1,86 @@
+package pr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+)
+
+// ListPRs returns a page of PRs matching filter, newest first by default
+func (s *Service) ListPRs(ctx context.Context, filter domain.PRFilter) ([]domain.PullRequestShort, string, error) {
+	err := filter.Normalize(domain.PRSortCreatedAt, domain.SortDesc, domain.PRSortCreatedAt, domain.PRSortID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch filter.Status {
+	case "", domain.StatusDraft, domain.StatusOpen, domain.StatusMerged, domain.StatusClosed:
+	default:
+		return nil, "", domain.NewError(domain.ErrCodeBadRequest, fmt.Sprintf("unknown status %s", filter.Status))
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return nil, "", domain.NewError(domain.ErrCodeBadRequest, "created_from must be before created_to")
+	}
+
+	prs, next, err := s.prRepo.List(ctx, filter)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, "", domain.NewError(domain.ErrCodeBadRequest, "invalid cursor")
+		}
+		s.log.Error(err.Error())
+		return nil, "", fmt.Errorf("failed to list PRs: %w", err)
+	}
+
+	return prs, next, nil
+}
+
+// GetPR returns full PR with reviewer states
+func (s *Service) GetPR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	return s.getPR(ctx, prID)
+}
+
+// GetPRs returns PRs in requested order, unknown IDs are reported separately
+func (s *Service) GetPRs(ctx context.Context, prIDs []string) ([]domain.PullRequest, []string, error) {
+	if len(prIDs) == 0 {
+		return nil, nil, domain.NewError(domain.ErrCodeBadRequest, "pull_request_id is required")
+	}
+	if len(prIDs) > domain.MaxBatchSize {
+		return nil, nil, domain.NewError(domain.ErrCodeBadRequest,
+			fmt.Sprintf("at most %d pull requests can be requested at once", domain.MaxBatchSize))
+	}
+
+	// Drop duplicates keeping the first occurrence
+	seen := make(map[string]bool, len(prIDs))
+	ids := make([]string, 0, len(prIDs))
+	for _, id := range prIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	found, err := s.prRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		s.log.Error(err.Error())
+		return nil, nil, fmt.Errorf("failed to get PRs: %w", err)
+	}
+
+	byID := make(map[string]domain.PullRequest, len(found))
+	for _, pr := range found {
+		byID[pr.ID] = pr
+	}
+	prs := make([]domain.PullRequest, 0, len(found))
+	notFound := make([]string, 0)
+	for _, id := range ids {
+		pr, ok := byID[id]
+		if !ok {
+			notFound = append(notFound, id)
+			continue
+		}
+		prs = append(prs, pr)
+	}
+
+	return prs, notFound, nil
+}
@@ -5,17 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/metrics"
 	"github.com/platonso/avito-pr-service/internal/repository"
 	"log/slog"
-	"math/rand"
 	"time"
 )
 
 type Service struct {
-	prRepo   repository.PRRepository
-	teamRepo repository.TeamRepository
-	userRepo repository.UserRepository
-	log      *slog.Logger
+	prRepo    repository.PRRepository
+	teamRepo  repository.TeamRepository
+	userRepo  repository.UserRepository
+	selectors map[domain.ReviewerStrategy]ReviewerSelector
+	log       *slog.Logger
 }
 
 func NewService(
@@ -25,14 +26,28 @@ func NewService(
 	log *slog.Logger,
 ) *Service {
 	return &Service{
-		prRepo:   prRepo,
-		teamRepo: teamRepo,
-		userRepo: userRepo,
-		log:      log,
+		prRepo:    prRepo,
+		teamRepo:  teamRepo,
+		userRepo:  userRepo,
+		selectors: newSelectors(),
+		log:       log,
 	}
 }
 
-func (s *Service) CreatePullRequest(ctx context.Context, prID, prName, authorID string) (*domain.PullRequest, error) {
+func (s *Service) CreatePullRequest(
+	ctx context.Context,
+	prID, prName, authorID string,
+	draft bool,
+	opts domain.ReviewerOptions,
+) (*domain.PullRequest, error) {
+	if !domain.CallerFromContext(ctx).CanActAs(authorID) {
+		s.log.Warn("PR created on behalf of another author",
+			slog.String("pr_id", prID),
+			slog.String("author_id", authorID),
+			slog.String("actor_id", domain.ActorID(ctx, "")))
+		return nil, domain.NewError(domain.ErrCodeForbidden, "cannot create PR on behalf of another author")
+	}
+
 	// Check author existence
 	_, err := s.userRepo.GetByID(ctx, authorID)
 	if err != nil {
@@ -40,6 +55,8 @@ func (s *Service) CreatePullRequest(ctx context.Context, prID, prName, authorID
 			s.log.Warn("PR author not found", slog.String("author_id", authorID))
 			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
 		}
+		s.log.Error(err.Error())
+		return nil, fmt.Errorf("failed to get author: %w", err)
 	}
 	// Get author's team
 	team, err := s.teamRepo.GetByUserID(ctx, authorID)
@@ -52,14 +69,27 @@ func (s *Service) CreatePullRequest(ctx context.Context, prID, prName, authorID
 		return nil, fmt.Errorf("failed to get author's team: %w", err)
 	}
 
-	// Get active members (without author)
-	activeMembers, err := s.getActiveTeamMembers(ctx, team.Name, authorID)
-	if err != nil {
+	// Validate per-PR reviewer options
+	if err = validateReviewerOptions(team, authorID, opts); err != nil {
+		s.log.Warn("invalid reviewer options", slog.String("pr_id", prID), slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	// Select 0-2 reviewers
-	reviewers := s.selectRandomReviewers(activeMembers, 2)
+	// Draft PRs get reviewers when marked ready
+	status := domain.StatusOpen
+	var reviewers, fallbackReviewers []string
+	if draft {
+		if opts.HasReviewerOverrides() {
+			return nil, domain.NewError(domain.ErrCodeBadRequest, "reviewer options are applied when draft PR is marked ready")
+		}
+		status = domain.StatusDraft
+		reviewers = []string{}
+	} else {
+		reviewers, fallbackReviewers, err = s.assignReviewers(ctx, prID, authorID, team, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// Create PR
 	prCreatedTime := time.Now()
@@ -67,12 +97,15 @@ func (s *Service) CreatePullRequest(ctx context.Context, prID, prName, authorID
 		ID:                prID,
 		Name:              prName,
 		AuthorID:          authorID,
-		Status:            domain.StatusOpen,
+		Status:            status,
 		CreatedAt:         prCreatedTime,
 		AssignedReviewers: reviewers,
+		FallbackReviewers: fallbackReviewers,
+		Reviewers:         newReviewers(reviewers, fallbackReviewers, prCreatedTime),
+		RequiredApprovals: opts.RequiredApprovals,
 	}
 
-	err = s.prRepo.Create(ctx, pr)
+	err = s.prRepo.Create(ctx, pr, domain.Audit{ActorID: domain.ActorID(ctx, authorID), Reason: reasonCreated})
 	if err != nil {
 		if errors.Is(err, repository.ErrPRAlreadyExists) {
 			s.log.Warn("PR already exists", slog.String("pr_id", prID))
@@ -81,11 +114,88 @@ func (s *Service) CreatePullRequest(ctx context.Context, prID, prName, authorID
 		s.log.Error(err.Error())
 		return nil, fmt.Errorf("failed to create PR: %w", err)
 	}
+	if status == domain.StatusOpen && len(reviewers) == 0 {
+		metrics.PRsWithoutReviewers.Inc()
+	}
 
 	return pr, nil
 }
 
-func (s *Service) MergePR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+// Select reviewers for PR from author's team and its fallback teams
+func (s *Service) assignReviewers(
+	ctx context.Context,
+	prID, authorID string,
+	team *domain.Team,
+	opts domain.ReviewerOptions,
+) (reviewers, fallbackReviewers []string, err error) {
+	// Get active members (without author)
+	activeMembers, err := s.getActiveTeamMembers(ctx, team.Name, authorID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Exclude required and excluded reviewers from random selection
+	candidates := s.filterOutReviewers(activeMembers, opts.RequiredReviewers)
+	candidates = s.filterOutReviewers(candidates, opts.ExcludedReviewers)
+
+	// Select reviewers within team limits or per-PR override
+	minCount, maxCount := reviewersCount(team)
+	if opts.ReviewersCount != nil {
+		minCount, maxCount = *opts.ReviewersCount, *opts.ReviewersCount
+	}
+	if len(opts.RequiredReviewers) > maxCount {
+		maxCount = len(opts.RequiredReviewers)
+	}
+
+	selected, err := s.selectReviewers(ctx, team, candidates, maxCount-len(opts.RequiredReviewers), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	reviewers = make([]string, 0, len(opts.RequiredReviewers)+len(selected))
+	reviewers = append(reviewers, opts.RequiredReviewers...)
+	reviewers = append(reviewers, selected...)
+
+	// Fill remaining slots from fallback teams
+	if len(reviewers) < maxCount {
+		exclude := append([]string{authorID}, reviewers...)
+		exclude = append(exclude, opts.ExcludedReviewers...)
+		fallbackReviewers, err = s.selectFallbackReviewers(ctx, team, maxCount-len(reviewers), nil, exclude...)
+		if err != nil {
+			return nil, nil, err
+		}
+		reviewers = append(reviewers, fallbackReviewers...)
+	}
+
+	if len(reviewers) < minCount {
+		s.log.Warn("not enough reviewers for PR",
+			slog.String("pr_id", prID),
+			slog.String("team_name", team.Name),
+			slog.Int("min_reviewers", minCount),
+			slog.Int("available", len(reviewers)))
+		metrics.NoCandidateErrors.WithLabelValues(metrics.OpAssign).Inc()
+		return nil, nil, domain.NewError(domain.ErrCodeNoCandidate, "not enough active reviewers in team")
+	}
+	if len(reviewers) == 0 {
+		s.log.Warn("PR has no reviewers",
+			slog.String("pr_id", prID),
+			slog.String("team_name", team.Name))
+	}
+
+	return reviewers, fallbackReviewers, nil
+}
+
+func (s *Service) MergePR(ctx context.Context, prID string, opts domain.MergeOptions) (*domain.PullRequest, error) {
+	caller := domain.CallerFromContext(ctx)
+	if opts.Force {
+		if caller == nil || caller.Role != domain.RoleAdmin {
+			s.log.Warn("force merge is not allowed", slog.String("pr_id", prID))
+			return nil, domain.NewError(domain.ErrCodeForbidden, "only admin can force merge")
+		}
+		if opts.Reason == "" {
+			return nil, domain.NewError(domain.ErrCodeBadRequest, "force merge requires reason")
+		}
+	}
+
 	// Get PR with reviewers
 	pr, err := s.prRepo.GetByID(ctx, prID)
 	if err != nil {
@@ -102,22 +212,72 @@ func (s *Service) MergePR(ctx context.Context, prID string) (*domain.PullRequest
 		s.log.Warn("PR already merged", slog.String("pr_id", prID))
 		return pr, nil
 	}
+	if err = s.checkTransition(pr, domain.StatusMerged); err != nil {
+		return nil, err
+	}
+	if err = s.checkAuthor(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	// Check merge policy of author's team
+	team, err := s.getAuthorTeam(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Override is offered on force and recorded only if the policy is violated at merge time
+	var override *domain.MergeOverride
+	if opts.Force {
+		override = &domain.MergeOverride{ActorID: caller.AuditID(), Reason: opts.Reason}
+	}
+	reasons := pr.MergePolicyViolations(team.Settings)
+	if len(reasons) > 0 && override == nil {
+		s.log.Warn("merge blocked by policy",
+			slog.String("pr_id", prID),
+			slog.Any("reasons", reasons))
+		return nil, domain.NewErrorWithDetails(domain.ErrCodeMergeBlocked, "merge policy is not satisfied", reasons)
+	}
 
-	// Merge PR
+	// Merge PR, reviews are re-checked under lock
 	mergeTime := time.Now()
-	err = s.prRepo.Merge(ctx, prID, mergeTime)
+	mergedBy := domain.ActorID(ctx, "")
+	override, err = s.prRepo.Merge(ctx, prID, mergeTime, mergedBy, team.Settings, override)
 	if err != nil {
+		if errors.Is(err, repository.ErrPRStatusChanged) {
+			s.log.Warn("PR status changed concurrently", slog.String("pr_id", prID))
+			return nil, domain.NewError(domain.ErrCodeInvalidTransition, "PR status changed concurrently")
+		}
+		var domainErr *domain.Error
+		if errors.As(err, &domainErr) {
+			s.log.Warn("merge blocked by policy",
+				slog.String("pr_id", prID),
+				slog.Any("reasons", domainErr.Details))
+			return nil, err
+		}
 		s.log.Error(err.Error())
 		return nil, fmt.Errorf("failed to merge PR: %w", err)
 	}
+	if override != nil {
+		s.log.Warn("merge policy overridden",
+			slog.String("pr_id", prID),
+			slog.String("actor_id", override.ActorID),
+			slog.String("reason", opts.Reason),
+			slog.Any("policy_violations", reasons))
+	}
 
 	pr.Status = domain.StatusMerged
 	pr.MergedAt = &mergeTime
+	pr.MergedBy = mergedBy
+	pr.MergeOverride = override
 
 	return pr, nil
 }
 
-func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (*domain.PullRequest, string, error) {
+func (s *Service) ReassignReviewer(
+	ctx context.Context,
+	prID, oldReviewerID string,
+	audit domain.Audit,
+) (*domain.PullRequest, string, error) {
 	// Get PR with reviewers
 	pr, err := s.prRepo.GetByID(ctx, prID)
 	if err != nil {
@@ -134,6 +294,10 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID stri
 		s.log.Warn("cannot reassign reviewer for merged PR", slog.String("pr_id", prID))
 		return nil, "", domain.NewError(domain.ErrCodePRMerged, "cannot reassign on merged PR")
 	}
+	if pr.Status != domain.StatusOpen {
+		s.log.Warn("cannot reassign reviewer for not open PR", slog.String("pr_id", prID))
+		return nil, "", domain.NewError(domain.ErrCodePRNotOpen, "cannot reassign on not open PR")
+	}
 
 	// Check that old reviewer assigned to PR
 	if !s.containsReviewer(pr.AssignedReviewers, oldReviewerID) {
@@ -143,11 +307,34 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID stri
 		return nil, "", domain.NewError(domain.ErrCodeNotAssigned, "reviewer is not assigned to this PR")
 	}
 
+	// PR author or the replaced reviewer may reassign
+	caller := domain.CallerFromContext(ctx)
+	if !caller.CanActAs(pr.AuthorID) && !caller.CanActAs(oldReviewerID) {
+		s.log.Warn("caller cannot reassign reviewer",
+			slog.String("pr_id", prID),
+			slog.String("actor_id", caller.UserID))
+		return nil, "", domain.NewError(domain.ErrCodeForbidden, "only PR author or the reviewer can reassign review")
+	}
+
 	// Get new reviewer's team
 	team, err := s.teamRepo.GetByUserID(ctx, oldReviewerID)
 	if err != nil {
-		s.log.Error(err.Error())
-		return nil, "", fmt.Errorf("failed to get reviewer's team: %w", err)
+		if !errors.Is(err, repository.ErrTeamNotFound) && !errors.Is(err, repository.ErrUserNotFound) {
+			s.log.Error(err.Error())
+			return nil, "", fmt.Errorf("failed to get reviewer's team: %w", err)
+		}
+		s.log.Warn("reviewer has no team, replacing from author's team",
+			slog.String("pr_id", prID),
+			slog.String("old_reviewer_id", oldReviewerID))
+	}
+
+	// Get author's team, its fallback teams are used when reviewer's team is exhausted
+	authorTeam, err := s.getAuthorTeam(ctx, pr)
+	if err != nil {
+		return nil, "", err
+	}
+	if team == nil {
+		team = authorTeam
 	}
 
 	// Get active team members (excluding author and old reviewer)
@@ -160,20 +347,42 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID stri
 	availableMembers := s.filterOutReviewers(activeMembers, pr.AssignedReviewers)
 
 	// Choose new reviewer
-	newReviewerID := s.selectRandomReviewer(availableMembers)
-	if newReviewerID == "" {
+	newReviewers, err := s.selectReviewers(ctx, team, availableMembers, 1, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(newReviewers) == 0 {
+		exclude := append([]string{oldReviewerID, pr.AuthorID}, pr.AssignedReviewers...)
+		newReviewers, err = s.selectFallbackReviewers(ctx, authorTeam, 1, nil, exclude...)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if len(newReviewers) == 0 {
 		s.log.Warn("no available reviewers for reassignment",
 			slog.String("pr_id", prID),
 			slog.String("old_reviewer_id", oldReviewerID))
+		metrics.NoCandidateErrors.WithLabelValues(metrics.OpReassign).Inc()
 		return nil, "", domain.NewError(domain.ErrCodeNoCandidate, "no active replacement candidate in team")
 	}
+	newReviewerID := newReviewers[0]
+	isFallback := !s.isTeamMember(authorTeam, newReviewerID)
 
 	// Change reviewers in DB
-	err = s.prRepo.ChangeReviewer(ctx, prID, oldReviewerID, newReviewerID)
+	audit.ActorID = domain.ActorID(ctx, audit.ActorID)
+	if audit.Reason == "" {
+		audit.Reason = reasonReassigned
+	}
+	err = s.prRepo.ChangeReviewer(ctx, prID, oldReviewerID, newReviewerID, isFallback, audit)
 	if err != nil {
+		if errors.Is(err, repository.ErrPRStatusChanged) {
+			s.log.Warn("PR status changed concurrently", slog.String("pr_id", prID))
+			return nil, "", domain.NewError(domain.ErrCodeInvalidTransition, "PR status changed concurrently")
+		}
 		s.log.Error(err.Error())
 		return nil, "", fmt.Errorf("failed to reassign reviewer: %w", err)
 	}
+	metrics.Reassignments.Inc()
 
 	// Updated PR locally
 	for i, id := range pr.AssignedReviewers {
@@ -182,53 +391,389 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID stri
 			break
 		}
 	}
+	pr.FallbackReviewers = s.filterOutIDs(pr.FallbackReviewers, oldReviewerID)
+	if isFallback {
+		pr.FallbackReviewers = append(pr.FallbackReviewers, newReviewerID)
+	}
+	for i, reviewer := range pr.Reviewers {
+		if reviewer.UserID == oldReviewerID {
+			pr.Reviewers[i] = domain.Reviewer{
+				UserID:     newReviewerID,
+				State:      domain.ReviewPending,
+				IsFallback: isFallback,
+				AssignedAt: time.Now(),
+			}
+			break
+		}
+	}
 
 	return pr, newReviewerID, nil
 }
 
-func (s *Service) getActiveTeamMembers(ctx context.Context, teamName string, excludeUserIDs ...string) ([]string, error) {
+func (s *Service) SubmitReview(
+	ctx context.Context,
+	prID, reviewerID string,
+	state domain.ReviewState,
+	comment string,
+) (*domain.PullRequest, error) {
+	if state != domain.ReviewApproved && state != domain.ReviewChangesRequested && state != domain.ReviewCommented {
+		return nil, domain.NewError(domain.ErrCodeBadRequest, "unknown review state")
+	}
+	if !domain.CallerFromContext(ctx).CanActAs(reviewerID) {
+		s.log.Warn("review submitted on behalf of another reviewer",
+			slog.String("pr_id", prID),
+			slog.String("reviewer_id", reviewerID),
+			slog.String("actor_id", domain.ActorID(ctx, "")))
+		return nil, domain.NewError(domain.ErrCodeForbidden, "cannot review on behalf of another reviewer")
+	}
+
+	// Get PR with reviewers
+	pr, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPRNotFound) {
+			s.log.Warn("PR not found", slog.String("pr_id", prID))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		s.log.Error(err.Error())
+		return nil, fmt.Errorf("failed to get PR: %w", err)
+	}
+
+	// Check that PR is not merged
+	if pr.Status == domain.StatusMerged {
+		s.log.Warn("cannot review merged PR", slog.String("pr_id", prID))
+		return nil, domain.NewError(domain.ErrCodePRMerged, "cannot review merged PR")
+	}
+	if pr.Status != domain.StatusOpen {
+		s.log.Warn("cannot review not open PR", slog.String("pr_id", prID))
+		return nil, domain.NewError(domain.ErrCodePRNotOpen, "cannot review not open PR")
+	}
+
+	// Check that reviewer assigned to PR
+	idx := -1
+	for i, reviewer := range pr.Reviewers {
+		if reviewer.UserID == reviewerID {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		s.log.Warn("reviewer not assigned to PR",
+			slog.String("pr_id", prID),
+			slog.String("reviewer_id", reviewerID))
+		return nil, domain.NewError(domain.ErrCodeNotAssigned, "reviewer is not assigned to this PR")
+	}
+
+	// A comment does not dismiss an earlier decision
+	current := pr.Reviewers[idx].State
+	if state == domain.ReviewCommented && current != domain.ReviewPending {
+		state = current
+	}
+
+	reviewedAt := time.Now()
+	err = s.prRepo.SetReviewState(ctx, prID, reviewerID, state, reviewedAt, comment)
+	if err != nil {
+		if errors.Is(err, repository.ErrPRStatusChanged) {
+			s.log.Warn("PR status changed concurrently", slog.String("pr_id", prID))
+			return nil, domain.NewError(domain.ErrCodeInvalidTransition, "PR status changed concurrently")
+		}
+		s.log.Error(err.Error())
+		return nil, fmt.Errorf("failed to submit review: %w", err)
+	}
+
+	pr.Reviewers[idx].State = state
+	pr.Reviewers[idx].ReviewedAt = &reviewedAt
+
+	return pr, nil
+}
+
+func (s *Service) getActiveTeamMembers(ctx context.Context, teamName string, excludeUserIDs ...string) ([]domain.TeamMember, error) {
 	team, err := s.teamRepo.GetByName(ctx, teamName)
 	if err != nil {
 		return nil, err
 	}
+	return activeMembers(team, excludeUserIDs...), nil
+}
+
+// Members available for review: active and not absent at the moment, archived teams have none
+func activeMembers(team *domain.Team, excludeUserIDs ...string) []domain.TeamMember {
+	if team.IsArchived() {
+		return nil
+	}
 
 	excludeSet := make(map[string]bool)
 	for _, id := range excludeUserIDs {
 		excludeSet[id] = true
 	}
 
-	var activeMembers []string
+	var members []domain.TeamMember
 	for _, member := range team.Members {
-		if *member.IsActive && !excludeSet[member.ID] {
-			activeMembers = append(activeMembers, member.ID)
+		if member.IsAvailable() && !excludeSet[member.ID] {
+			members = append(members, member)
 		}
 	}
+	return members
+}
+
+// Draw reviewers from team's fallback teams in priority order
+func (s *Service) selectFallbackReviewers(
+	ctx context.Context,
+	team *domain.Team,
+	count int,
+	planned plannedCursors,
+	excludeUserIDs ...string,
+) ([]string, error) {
+	if team.Settings == nil || count <= 0 {
+		return nil, nil
+	}
+
+	var reviewers []string
+	for _, fallbackName := range team.Settings.FallbackTeams {
+		if len(reviewers) >= count {
+			break
+		}
 
-	return activeMembers, nil
+		fallbackTeam, err := s.teamRepo.GetByName(ctx, fallbackName)
+		if err != nil {
+			if errors.Is(err, repository.ErrTeamNotFound) {
+				s.log.Warn("fallback team not found",
+					slog.String("team_name", team.Name),
+					slog.String("fallback_team", fallbackName))
+				continue
+			}
+			s.log.Error(err.Error())
+			return nil, fmt.Errorf("failed to get fallback team: %w", err)
+		}
+
+		exclude := make([]string, 0, len(excludeUserIDs)+len(reviewers))
+		exclude = append(exclude, excludeUserIDs...)
+		exclude = append(exclude, reviewers...)
+		members := activeMembers(fallbackTeam, exclude...)
+		selected, err := s.selectReviewers(ctx, fallbackTeam, members, count-len(reviewers), planned)
+		if err != nil {
+			return nil, err
+		}
+		reviewers = append(reviewers, selected...)
+	}
+
+	if len(reviewers) > 0 {
+		s.log.Info("reviewers assigned from fallback teams",
+			slog.String("team_name", team.Name),
+			slog.Any("reviewers", reviewers))
+	}
+	return reviewers, nil
+}
+
+// Select reviewers using the team's strategy. Round-robin position is taken from planned cursors when planning
+// and from the team's stored cursor otherwise
+func (s *Service) selectReviewers(
+	ctx context.Context,
+	team *domain.Team,
+	members []domain.TeamMember,
+	maxCount int,
+	planned plannedCursors,
+) ([]string, error) {
+	if len(members) == 0 || maxCount <= 0 {
+		return []string{}, nil
+	}
+
+	loads := map[string]int{}
+	if usesLoad(s.strategyFor(team)) {
+		userIDs := make([]string, 0, len(members))
+		for _, member := range members {
+			userIDs = append(userIDs, member.ID)
+		}
+
+		var err error
+		loads, err = s.prRepo.GetOpenReviewCounts(ctx, userIDs)
+		if err != nil {
+			s.log.Error(err.Error())
+			return nil, fmt.Errorf("failed to get reviewers load: %w", err)
+		}
+	}
+
+	candidates := make([]Candidate, 0, len(members))
+	for _, member := range members {
+		candidates = append(candidates, Candidate{
+			UserID:      member.ID,
+			OpenReviews: loads[member.ID],
+			Weight:      member.ReviewWeight,
+		})
+	}
+
+	return s.pickReviewers(ctx, team, candidates, maxCount, planned)
 }
 
-func (s *Service) selectRandomReviewers(candidates []string, maxCount int) []string {
-	if len(candidates) == 0 {
-		return []string{}
+// Pick up to count candidates with the team's strategy
+func (s *Service) pickReviewers(
+	ctx context.Context,
+	team *domain.Team,
+	candidates []Candidate,
+	count int,
+	planned plannedCursors,
+) ([]string, error) {
+	strategy := s.strategyFor(team)
+	if strategy != domain.StrategyRoundRobin {
+		return s.selectors[strategy].Select(candidates, count), nil
 	}
 
-	shuffled := make([]string, len(candidates))
-	copy(shuffled, candidates)
-	rand.Shuffle(len(shuffled), func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
+	count = min(count, len(candidates))
+	if count <= 0 {
+		return []string{}, nil
+	}
 
-	if len(shuffled) > maxCount {
-		return shuffled[:maxCount]
+	var cursor int64
+	if planned != nil {
+		cursor = planned.advance(team, count)
+	} else {
+		var err error
+		cursor, err = s.teamRepo.AdvanceRRCursor(ctx, team.Name, count)
+		if err != nil {
+			s.log.Error(err.Error())
+			return nil, fmt.Errorf("failed to advance round-robin cursor: %w", err)
+		}
 	}
-	return shuffled
+	return (&RoundRobinSelector{Cursor: cursor}).Select(candidates, count), nil
+}
+
+// Only load-based strategies need open review counts of candidates
+func usesLoad(strategy domain.ReviewerStrategy) bool {
+	return strategy == domain.StrategyLeastLoaded || strategy == domain.StrategyWeighted
 }
 
-func (s *Service) selectRandomReviewer(candidates []string) string {
-	if len(candidates) == 0 {
-		return ""
+// Round-robin cursors of teams advanced while planning reassignments, nothing is stored
+type plannedCursors map[string]int64
+
+func (c plannedCursors) advance(team *domain.Team, count int) int64 {
+	cursor, ok := c[team.Name]
+	if !ok {
+		cursor = team.RRCursor
 	}
-	return candidates[rand.Intn(len(candidates))]
+	c[team.Name] = cursor + int64(count)
+	return cursor
+}
+
+// Check that required and excluded reviewers belong to the author's team
+func validateReviewerOptions(team *domain.Team, authorID string, opts domain.ReviewerOptions) error {
+	if opts.ReviewersCount != nil {
+		count := *opts.ReviewersCount
+		if count < 0 || count > domain.MaxReviewersLimit {
+			return domain.NewError(domain.ErrCodeBadRequest,
+				fmt.Sprintf("reviewers_count must be between 0 and %d", domain.MaxReviewersLimit))
+		}
+		if count < len(opts.RequiredReviewers) {
+			return domain.NewError(domain.ErrCodeBadRequest, "reviewers_count is less than number of required reviewers")
+		}
+	}
+
+	if opts.RequiredApprovals != nil {
+		if *opts.RequiredApprovals < 0 || *opts.RequiredApprovals > domain.MaxReviewersLimit {
+			return domain.NewError(domain.ErrCodeBadRequest,
+				fmt.Sprintf("required_approvals must be between 0 and %d", domain.MaxReviewersLimit))
+		}
+	}
+
+	members := make(map[string]domain.TeamMember, len(team.Members))
+	for _, member := range team.Members {
+		members[member.ID] = member
+	}
+
+	excluded := make(map[string]bool, len(opts.ExcludedReviewers))
+	for _, id := range opts.ExcludedReviewers {
+		if _, ok := members[id]; !ok {
+			return domain.NewError(domain.ErrCodeBadRequest,
+				fmt.Sprintf("excluded reviewer %s is not a member of team %s", id, team.Name))
+		}
+		excluded[id] = true
+	}
+
+	required := make(map[string]bool, len(opts.RequiredReviewers))
+	for _, id := range opts.RequiredReviewers {
+		if id == authorID {
+			return domain.NewError(domain.ErrCodeBadRequest, "author cannot be a required reviewer")
+		}
+		member, ok := members[id]
+		if !ok {
+			return domain.NewError(domain.ErrCodeBadRequest,
+				fmt.Sprintf("required reviewer %s is not a member of team %s", id, team.Name))
+		}
+		if member.IsActive == nil || !*member.IsActive {
+			return domain.NewError(domain.ErrCodeBadRequest,
+				fmt.Sprintf("required reviewer %s is not active", id))
+		}
+		if member.IsAbsent {
+			return domain.NewError(domain.ErrCodeBadRequest,
+				fmt.Sprintf("required reviewer %s is absent", id))
+		}
+		if excluded[id] {
+			return domain.NewError(domain.ErrCodeBadRequest,
+				fmt.Sprintf("reviewer %s is both required and excluded", id))
+		}
+		if required[id] {
+			return domain.NewError(domain.ErrCodeBadRequest, "duplicate required reviewer")
+		}
+		required[id] = true
+	}
+
+	return nil
+}
+
+func reviewersCount(team *domain.Team) (minCount, maxCount int) {
+	if team.Settings == nil {
+		return domain.DefaultMinReviewers, domain.DefaultMaxReviewers
+	}
+	return team.Settings.MinReviewers, team.Settings.MaxReviewers
+}
+
+func (s *Service) strategyFor(team *domain.Team) domain.ReviewerStrategy {
+	strategy := domain.DefaultReviewerStrategy
+	if team.Settings != nil && team.Settings.ReviewerStrategy != "" {
+		strategy = team.Settings.ReviewerStrategy
+	}
+
+	if _, ok := s.selectors[strategy]; !ok && strategy != domain.StrategyRoundRobin {
+		s.log.Warn("unknown reviewer strategy, falling back to default",
+			slog.String("team_name", team.Name),
+			slog.String("strategy", string(strategy)))
+		return domain.DefaultReviewerStrategy
+	}
+	return strategy
+}
+
+func newReviewers(reviewerIDs, fallbackIDs []string, assignedAt time.Time) []domain.Reviewer {
+	fallbackSet := make(map[string]bool, len(fallbackIDs))
+	for _, id := range fallbackIDs {
+		fallbackSet[id] = true
+	}
+
+	reviewers := make([]domain.Reviewer, 0, len(reviewerIDs))
+	for _, id := range reviewerIDs {
+		reviewers = append(reviewers, domain.Reviewer{
+			UserID:     id,
+			State:      domain.ReviewPending,
+			IsFallback: fallbackSet[id],
+			AssignedAt: assignedAt,
+		})
+	}
+	return reviewers
+}
+
+func (s *Service) isTeamMember(team *domain.Team, userID string) bool {
+	for _, member := range team.Members {
+		if member.ID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Service) filterOutIDs(ids []string, excludeID string) []string {
+	var result []string
+	for _, id := range ids {
+		if id != excludeID {
+			result = append(result, id)
+		}
+	}
+	return result
 }
 
 func (s *Service) containsReviewer(reviewers []string, reviewerID string) bool {
@@ -241,15 +786,15 @@ func (s *Service) containsReviewer(reviewers []string, reviewerID string) bool {
 }
 
 // Exclude assigned reviewers
-func (s *Service) filterOutReviewers(candidates []string, existingReviewers []string) []string {
+func (s *Service) filterOutReviewers(candidates []domain.TeamMember, existingReviewers []string) []domain.TeamMember {
 	existingSet := make(map[string]bool)
 	for _, id := range existingReviewers {
 		existingSet[id] = true
 	}
 
-	var result []string
+	var result []domain.TeamMember
 	for _, candidate := range candidates {
-		if !existingSet[candidate] {
+		if !existingSet[candidate.ID] {
 			result = append(result, candidate)
 		}
 	}
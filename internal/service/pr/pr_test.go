@@ -16,21 +16,70 @@ import (
 
 type MockPRRepository struct {
 	CreateFunc         func(ctx context.Context, pr *domain.PullRequest) error
-	MergeFunc          func(ctx context.Context, prID string, mergedAt time.Time) error
 	GetByIDFunc        func(ctx context.Context, prID string) (*domain.PullRequest, error)
-	ChangeReviewerFunc func(ctx context.Context, prID, oldReviewerID, newReviewerID string) error
+	ChangeReviewerFunc func(
+		ctx context.Context,
+		prID, oldReviewerID, newReviewerID string,
+		isFallback bool,
+		audit domain.Audit,
+	) error
+
+	MergeFunc func(
+		ctx context.Context,
+		prID string,
+		mergedAt time.Time,
+		mergedBy string,
+		settings *domain.TeamSettings,
+		override *domain.MergeOverride,
+	) (*domain.MergeOverride, error)
+
+	UpdateStatusFunc func(
+		ctx context.Context,
+		prID string,
+		from, to domain.PRStatus,
+		newReviewers []domain.Reviewer,
+		removedReviewerIDs []string,
+	) error
+
+	GetOpenReviewCountsFunc func(ctx context.Context, userIDs []string) (map[string]int, error)
+	SetReviewStateFunc      func(ctx context.Context, prID, reviewerID string, state domain.ReviewState, reviewedAt time.Time) error
+	ExistsFunc              func(ctx context.Context, prID string) (bool, error)
+	GetReviewerEventsFunc   func(ctx context.Context, prID string) ([]domain.ReviewerEvent, error)
+	ListFunc                func(ctx context.Context, filter domain.PRFilter) ([]domain.PullRequestShort, string, error)
+	GetByIDsFunc            func(ctx context.Context, prIDs []string) ([]domain.PullRequest, error)
 }
 
-func (m *MockPRRepository) Create(ctx context.Context, pr *domain.PullRequest) error {
+func (m *MockPRRepository) Create(ctx context.Context, pr *domain.PullRequest, audit domain.Audit) error {
 	if m.CreateFunc != nil {
 		return m.CreateFunc(ctx, pr)
 	}
 	return nil
 }
 
-func (m *MockPRRepository) Merge(ctx context.Context, prID string, mergedAt time.Time) error {
+func (m *MockPRRepository) Merge(
+	ctx context.Context,
+	prID string,
+	mergedAt time.Time,
+	mergedBy string,
+	settings *domain.TeamSettings,
+	override *domain.MergeOverride,
+) (*domain.MergeOverride, error) {
 	if m.MergeFunc != nil {
-		return m.MergeFunc(ctx, prID, mergedAt)
+		return m.MergeFunc(ctx, prID, mergedAt, mergedBy, settings, override)
+	}
+	return override, nil
+}
+
+func (m *MockPRRepository) UpdateStatus(
+	ctx context.Context,
+	prID string,
+	from, to domain.PRStatus,
+	newReviewers []domain.Reviewer,
+	removedReviewerIDs []string,
+	audit domain.Audit,
+) error {
+	if m.UpdateStatusFunc != nil {
+		return m.UpdateStatusFunc(ctx, prID, from, to, newReviewers, removedReviewerIDs)
 	}
 	return nil
 }
@@ -42,9 +91,14 @@ func (m *MockPRRepository) GetByID(ctx context.Context, prID string) (*domain.Pu
 	return nil, nil
 }
 
-func (m *MockPRRepository) ChangeReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error {
+func (m *MockPRRepository) ChangeReviewer(
+	ctx context.Context,
+	prID, oldReviewerID, newReviewerID string,
+	isFallback bool,
+	audit domain.Audit,
+) error {
 	if m.ChangeReviewerFunc != nil {
-		return m.ChangeReviewerFunc(ctx, prID, oldReviewerID, newReviewerID)
+		return m.ChangeReviewerFunc(ctx, prID, oldReviewerID, newReviewerID, isFallback, audit)
 	}
 	return nil
 }
@@ -52,17 +106,65 @@ func (m *MockPRRepository) ChangeReviewer(ctx context.Context, prID, oldReviewer
 func (m *MockPRRepository) GetReviewersIDs(ctx context.Context, prID string) ([]string, error) {
 	return nil, nil
 }
-func (m *MockPRRepository) Exists(ctx context.Context, prID string) (bool, error) { return false, nil }
-func (m *MockPRRepository) GetReviewerStats(ctx context.Context) ([]domain.ReviewerStat, error) {
+func (m *MockPRRepository) GetOpenReviewCounts(ctx context.Context, userIDs []string) (map[string]int, error) {
+	if m.GetOpenReviewCountsFunc != nil {
+		return m.GetOpenReviewCountsFunc(ctx, userIDs)
+	}
 	return nil, nil
 }
-func (m *MockPRRepository) GetRRStats(ctx context.Context) ([]domain.PullRequestStat, error) {
+func (m *MockPRRepository) SetReviewState(
+	ctx context.Context,
+	prID, reviewerID string,
+	state domain.ReviewState,
+	reviewedAt time.Time,
+	comment string,
+) error {
+	if m.SetReviewStateFunc != nil {
+		return m.SetReviewStateFunc(ctx, prID, reviewerID, state, reviewedAt)
+	}
+	return nil
+}
+func (m *MockPRRepository) Exists(ctx context.Context, prID string) (bool, error) {
+	if m.ExistsFunc != nil {
+		return m.ExistsFunc(ctx, prID)
+	}
+	return false, nil
+}
+func (m *MockPRRepository) CountOpen(ctx context.Context) (int, error) {
+	return 0, nil
+}
+func (m *MockPRRepository) GetReviewerEvents(ctx context.Context, prID string) ([]domain.ReviewerEvent, error) {
+	if m.GetReviewerEventsFunc != nil {
+		return m.GetReviewerEventsFunc(ctx, prID)
+	}
+	return nil, nil
+}
+func (m *MockPRRepository) GetByIDs(ctx context.Context, prIDs []string) ([]domain.PullRequest, error) {
+	if m.GetByIDsFunc != nil {
+		return m.GetByIDsFunc(ctx, prIDs)
+	}
+	return nil, nil
+}
+func (m *MockPRRepository) List(ctx context.Context, filter domain.PRFilter) ([]domain.PullRequestShort, string, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, filter)
+	}
+	return nil, "", nil
+}
+func (m *MockPRRepository) GetReviewerStats(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerStat, error) {
+	return nil, nil
+}
+func (m *MockPRRepository) GetRRStats(ctx context.Context, filter domain.StatsFilter) ([]domain.PullRequestStat, error) {
+	return nil, nil
+}
+func (m *MockPRRepository) GetLatencyStats(ctx context.Context, filter domain.StatsFilter) (*domain.LatencyReport, error) {
 	return nil, nil
 }
 
 type MockTeamRepository struct {
-	GetByUserIDFunc func(ctx context.Context, userID string) (*domain.Team, error)
-	GetByNameFunc   func(ctx context.Context, teamName string) (*domain.Team, error)
+	GetByUserIDFunc     func(ctx context.Context, userID string) (*domain.Team, error)
+	GetByNameFunc       func(ctx context.Context, teamName string) (*domain.Team, error)
+	AdvanceRRCursorFunc func(ctx context.Context, teamName string, count int) (int64, error)
 }
 
 func (m *MockTeamRepository) GetByUserID(ctx context.Context, userID string) (*domain.Team, error) {
@@ -79,15 +181,66 @@ func (m *MockTeamRepository) GetByName(ctx context.Context, teamName string) (*d
 	return nil, nil
 }
 
-func (m *MockTeamRepository) CreateWithMembers(ctx context.Context, team *domain.Team) error {
+func (m *MockTeamRepository) UpdateSettings(
+	ctx context.Context,
+	teamName string,
+	patch *domain.TeamSettingsPatch,
+) (*domain.TeamSettings, error) {
+	return nil, nil
+}
+func (m *MockTeamRepository) CreateWithMembers(ctx context.Context, team *domain.Team, audit domain.Audit) error {
 	return nil
 }
 func (m *MockTeamRepository) Exists(ctx context.Context, teamName string) (bool, error) {
 	return false, nil
 }
+func (m *MockTeamRepository) AddMembers(
+	ctx context.Context,
+	teamName string,
+	members []domain.TeamMember,
+	audit domain.Audit,
+) error {
+	return nil
+}
+func (m *MockTeamRepository) RemoveMember(
+	ctx context.Context,
+	teamName, userID string,
+	reassignments []domain.ReviewReassignment,
+	audit domain.Audit,
+) error {
+	return nil
+}
+func (m *MockTeamRepository) MoveMember(ctx context.Context, userID, fromTeam, toTeam string, audit domain.Audit) error {
+	return nil
+}
+func (m *MockTeamRepository) AdvanceRRCursor(ctx context.Context, teamName string, count int) (int64, error) {
+	if m.AdvanceRRCursorFunc != nil {
+		return m.AdvanceRRCursorFunc(ctx, teamName, count)
+	}
+	return 0, nil
+}
+func (m *MockTeamRepository) Archive(ctx context.Context, teamName string, archivedAt time.Time) error {
+	return nil
+}
+func (m *MockTeamRepository) Delete(ctx context.Context, teamName string) error {
+	return nil
+}
+func (m *MockTeamRepository) List(ctx context.Context, filter domain.TeamFilter) ([]domain.TeamSummary, string, error) {
+	return nil, "", nil
+}
+func (m *MockTeamRepository) DeactivateMembers(
+	ctx context.Context,
+	teamName string,
+	userIDs []string,
+	reassignments []domain.ReviewReassignment,
+	audit domain.Audit,
+) error {
+	return nil
+}
 
 type MockUserRepository struct {
-	GetByIDFunc func(ctx context.Context, userID string) (*domain.User, error)
+	GetByIDFunc        func(ctx context.Context, userID string) (*domain.User, error)
+	GetPRsByUserIDFunc func(ctx context.Context, userID string) ([]domain.PullRequestShort, error)
 }
 
 func (m *MockUserRepository) GetByID(ctx context.Context, userID string) (*domain.User, error) {
@@ -97,10 +250,39 @@ func (m *MockUserRepository) GetByID(ctx context.Context, userID string) (*domai
 	return nil, nil
 }
 
-func (m *MockUserRepository) SetIsActive(ctx context.Context, userID string, isActive bool) error {
+func (m *MockUserRepository) SetIsActive(ctx context.Context, userID string, isActive bool, audit domain.Audit) error {
 	return nil
 }
+func (m *MockUserRepository) Deactivate(
+	ctx context.Context,
+	userID string,
+	reassignments []domain.ReviewReassignment,
+	audit domain.Audit,
+) error {
+	return nil
+}
+func (m *MockUserRepository) CreateAbsence(ctx context.Context, absence *domain.Absence) error {
+	return nil
+}
+func (m *MockUserRepository) GetAbsences(ctx context.Context, userID string) ([]domain.Absence, error) {
+	return nil, nil
+}
+func (m *MockUserRepository) UpdateAbsence(ctx context.Context, absence *domain.Absence) error {
+	return nil
+}
+func (m *MockUserRepository) DeleteAbsence(ctx context.Context, absenceID int64) error {
+	return nil
+}
+func (m *MockUserRepository) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, string, error) {
+	return nil, "", nil
+}
+func (m *MockUserRepository) GetWorkload(ctx context.Context, userID string) (*domain.UserWorkload, error) {
+	return &domain.UserWorkload{}, nil
+}
 func (m *MockUserRepository) GetPRsByUserID(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
+	if m.GetPRsByUserIDFunc != nil {
+		return m.GetPRsByUserIDFunc(ctx, userID)
+	}
 	return nil, nil
 }
 
@@ -147,6 +329,31 @@ func TestService_CreatePullRequest(t *testing.T) {
 			},
 			expectedError: domain.NewError(domain.ErrCodeNotFound, "resource not found"),
 		},
+		{
+			name: "not enough reviewers for team minimum",
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository, userRepo *MockUserRepository) {
+				active := true
+				userRepo.GetByIDFunc = func(ctx context.Context, userID string) (*domain.User, error) {
+					return &domain.User{ID: userID, IsActive: &active}, nil
+				}
+				teamRepo.GetByUserIDFunc = func(ctx context.Context, userID string) (*domain.Team, error) {
+					return &domain.Team{
+						Name:     "team-1",
+						Settings: &domain.TeamSettings{MinReviewers: 2, MaxReviewers: 3},
+					}, nil
+				}
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return &domain.Team{
+						Name: "team-1",
+						Members: []domain.TeamMember{
+							{ID: "user-1", IsActive: &active},
+							{ID: "user-2", IsActive: &active},
+						},
+					}, nil
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNoCandidate, "not enough active reviewers in team"),
+		},
 		{
 			name: "PR already exists",
 			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository, userRepo *MockUserRepository) {
@@ -181,7 +388,7 @@ func TestService_CreatePullRequest(t *testing.T) {
 			tt.setupMocks(prRepo, teamRepo, userRepo)
 
 			service := NewService(prRepo, teamRepo, userRepo, getTestLogger())
-			result, err := service.CreatePullRequest(context.Background(), "pr-1", "Test PR", "user-1")
+			result, err := service.CreatePullRequest(context.Background(), "pr-1", "Test PR", "user-1", false, domain.ReviewerOptions{})
 
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -197,50 +404,500 @@ func TestService_CreatePullRequest(t *testing.T) {
 	}
 }
 
+func TestService_CreatePullRequest_AuthorLookupError(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	userRepo := &MockUserRepository{
+		GetByIDFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			return nil, dbErr
+		},
+	}
+	teamRepo := &MockTeamRepository{
+		GetByUserIDFunc: func(ctx context.Context, userID string) (*domain.Team, error) {
+			t.Fatal("team must not be loaded after failed author lookup")
+			return nil, nil
+		},
+	}
+
+	service := NewService(&MockPRRepository{}, teamRepo, userRepo, getTestLogger())
+	result, err := service.CreatePullRequest(context.Background(), "pr-1", "Test PR", "user-1", false, domain.ReviewerOptions{})
+
+	require.ErrorIs(t, err, dbErr)
+	var domainErr *domain.Error
+	assert.False(t, errors.As(err, &domainErr))
+	assert.Nil(t, result)
+}
+
+func TestService_CreatePullRequest_PrefersLeastLoaded(t *testing.T) {
+	active := true
+	prRepo := &MockPRRepository{
+		GetOpenReviewCountsFunc: func(ctx context.Context, userIDs []string) (map[string]int, error) {
+			return map[string]int{"user-2": 15, "user-3": 0, "user-4": 1}, nil
+		},
+	}
+	teamRepo := &MockTeamRepository{
+		GetByUserIDFunc: func(ctx context.Context, userID string) (*domain.Team, error) {
+			return &domain.Team{Name: "team-1"}, nil
+		},
+		GetByNameFunc: func(ctx context.Context, teamName string) (*domain.Team, error) {
+			return &domain.Team{
+				Name: "team-1",
+				Members: []domain.TeamMember{
+					{ID: "user-1", IsActive: &active},
+					{ID: "user-2", IsActive: &active},
+					{ID: "user-3", IsActive: &active},
+					{ID: "user-4", IsActive: &active},
+				},
+			}, nil
+		},
+	}
+	userRepo := &MockUserRepository{
+		GetByIDFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			return &domain.User{ID: userID, IsActive: &active}, nil
+		},
+	}
+
+	service := NewService(prRepo, teamRepo, userRepo, getTestLogger())
+	result, err := service.CreatePullRequest(context.Background(), "pr-1", "Test PR", "user-1", false, domain.ReviewerOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user-3", "user-4"}, result.AssignedReviewers)
+}
+
+func TestService_CreatePullRequest_RoundRobinStoredCursor(t *testing.T) {
+	active := true
+	team := &domain.Team{
+		Name:     "team-1",
+		Settings: &domain.TeamSettings{ReviewerStrategy: domain.StrategyRoundRobin, MaxReviewers: 2},
+		Members: []domain.TeamMember{
+			{ID: "user-1", IsActive: &active},
+			{ID: "user-2", IsActive: &active},
+			{ID: "user-3", IsActive: &active},
+			{ID: "user-4", IsActive: &active},
+		},
+	}
+	prRepo := &MockPRRepository{
+		GetOpenReviewCountsFunc: func(ctx context.Context, userIDs []string) (map[string]int, error) {
+			t.Fatal("round-robin does not need reviewers load")
+			return nil, nil
+		},
+	}
+	var advanced []int
+	teamRepo := &MockTeamRepository{
+		GetByUserIDFunc: func(ctx context.Context, userID string) (*domain.Team, error) {
+			return team, nil
+		},
+		GetByNameFunc: func(ctx context.Context, teamName string) (*domain.Team, error) {
+			return team, nil
+		},
+		AdvanceRRCursorFunc: func(ctx context.Context, teamName string, count int) (int64, error) {
+			assert.Equal(t, "team-1", teamName)
+			advanced = append(advanced, count)
+			return 2, nil
+		},
+	}
+	userRepo := &MockUserRepository{
+		GetByIDFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			return &domain.User{ID: userID, IsActive: &active}, nil
+		},
+	}
+
+	service := NewService(prRepo, teamRepo, userRepo, getTestLogger())
+	result, err := service.CreatePullRequest(context.Background(), "pr-1", "Test PR", "user-1", false, domain.ReviewerOptions{})
+
+	// Stored cursor 2 over user-2..user-4 starts at user-4
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user-4", "user-2"}, result.AssignedReviewers)
+	assert.Equal(t, []int{2}, advanced)
+}
+
+func TestService_CreatePullRequest_ReviewerOptions(t *testing.T) {
+	active, inactive := true, false
+	count := func(v int) *int { return &v }
+	team := &domain.Team{
+		Name: "team-1",
+		Members: []domain.TeamMember{
+			{ID: "user-1", IsActive: &active},
+			{ID: "user-2", IsActive: &active},
+			{ID: "user-3", IsActive: &active},
+			{ID: "user-4", IsActive: &active},
+			{ID: "user-5", IsActive: &inactive},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		opts           domain.ReviewerOptions
+		expectedError  *domain.Error
+		validateResult func(*testing.T, *domain.PullRequest)
+	}{
+		{
+			name: "single reviewer for hotfix",
+			opts: domain.ReviewerOptions{ReviewersCount: count(1)},
+			validateResult: func(t *testing.T, pr *domain.PullRequest) {
+				assert.Len(t, pr.AssignedReviewers, 1)
+			},
+		},
+		{
+			name: "required reviewer is always assigned",
+			opts: domain.ReviewerOptions{RequiredReviewers: []string{"user-4"}},
+			validateResult: func(t *testing.T, pr *domain.PullRequest) {
+				assert.Len(t, pr.AssignedReviewers, 2)
+				assert.Equal(t, "user-4", pr.AssignedReviewers[0])
+			},
+		},
+		{
+			name: "excluded reviewers are skipped",
+			opts: domain.ReviewerOptions{ExcludedReviewers: []string{"user-2", "user-3"}},
+			validateResult: func(t *testing.T, pr *domain.PullRequest) {
+				assert.Equal(t, []string{"user-4"}, pr.AssignedReviewers)
+			},
+		},
+		{
+			name:          "required reviewer from another team",
+			opts:          domain.ReviewerOptions{RequiredReviewers: []string{"stranger"}},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, ""),
+		},
+		{
+			name:          "inactive required reviewer",
+			opts:          domain.ReviewerOptions{RequiredReviewers: []string{"user-5"}},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, ""),
+		},
+		{
+			name:          "author as required reviewer",
+			opts:          domain.ReviewerOptions{RequiredReviewers: []string{"user-1"}},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, ""),
+		},
+		{
+			name: "reviewers count less than required",
+			opts: domain.ReviewerOptions{
+				ReviewersCount:    count(1),
+				RequiredReviewers: []string{"user-2", "user-3"},
+			},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, ""),
+		},
+		{
+			name:          "not enough candidates for requested count",
+			opts:          domain.ReviewerOptions{ReviewersCount: count(4)},
+			expectedError: domain.NewError(domain.ErrCodeNoCandidate, ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamRepo := &MockTeamRepository{
+				GetByUserIDFunc: func(ctx context.Context, userID string) (*domain.Team, error) {
+					return team, nil
+				},
+				GetByNameFunc: func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return team, nil
+				},
+			}
+			userRepo := &MockUserRepository{
+				GetByIDFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+					return &domain.User{ID: userID, IsActive: &active}, nil
+				},
+			}
+
+			service := NewService(&MockPRRepository{}, teamRepo, userRepo, getTestLogger())
+			result, err := service.CreatePullRequest(context.Background(), "pr-1", "Test PR", "user-1", false, tt.opts)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.validateResult(t, result)
+		})
+	}
+}
+
+func TestService_CreatePullRequest_FallbackTeams(t *testing.T) {
+	active := true
+	teams := map[string]*domain.Team{
+		"team-1": {
+			Name:     "team-1",
+			Members:  []domain.TeamMember{{ID: "user-1", IsActive: &active}},
+			Settings: &domain.TeamSettings{MaxReviewers: 2, FallbackTeams: []string{"team-2", "team-3"}},
+		},
+		"team-2": {
+			Name:    "team-2",
+			Members: []domain.TeamMember{{ID: "user-2", IsActive: &active}},
+		},
+		"team-3": {
+			Name:    "team-3",
+			Members: []domain.TeamMember{{ID: "user-3", IsActive: &active}},
+		},
+	}
+
+	var created *domain.PullRequest
+	prRepo := &MockPRRepository{
+		CreateFunc: func(ctx context.Context, pr *domain.PullRequest) error {
+			created = pr
+			return nil
+		},
+	}
+	teamRepo := &MockTeamRepository{
+		GetByUserIDFunc: func(ctx context.Context, userID string) (*domain.Team, error) {
+			return teams["team-1"], nil
+		},
+		GetByNameFunc: func(ctx context.Context, teamName string) (*domain.Team, error) {
+			return teams[teamName], nil
+		},
+	}
+	userRepo := &MockUserRepository{
+		GetByIDFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			return &domain.User{ID: userID, IsActive: &active}, nil
+		},
+	}
+
+	service := NewService(prRepo, teamRepo, userRepo, getTestLogger())
+	result, err := service.CreatePullRequest(context.Background(), "pr-1", "Test PR", "user-1", false, domain.ReviewerOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user-2", "user-3"}, result.AssignedReviewers)
+	assert.Equal(t, []string{"user-2", "user-3"}, result.FallbackReviewers)
+	assert.Equal(t, result, created)
+}
+
 func TestService_MergePR(t *testing.T) {
+	approved := func(ids ...string) []domain.Reviewer {
+		reviewers := make([]domain.Reviewer, 0, len(ids))
+		for _, id := range ids {
+			reviewers = append(reviewers, domain.Reviewer{UserID: id, State: domain.ReviewApproved})
+		}
+		return reviewers
+	}
+	teamWithApprovals := func(required int) *domain.Team {
+		settings := domain.DefaultTeamSettings()
+		settings.RequiredApprovals = required
+		return &domain.Team{Name: "team-1", Settings: settings}
+	}
+
+	admin := domain.WithCaller(context.Background(), &domain.Caller{UserID: "u9", Role: domain.RoleAdmin})
+
 	tests := []struct {
 		name          string
-		setupMocks    func(prRepo *MockPRRepository)
+		ctx           context.Context
+		opts          domain.MergeOptions
+		setupMocks    func(prRepo *MockPRRepository, teamRepo *MockTeamRepository)
 		expectedError *domain.Error
 	}{
 		{
 			name: "successful merge",
-			setupMocks: func(prRepo *MockPRRepository) {
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {
 				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
 					return &domain.PullRequest{
 						ID:     prID,
 						Status: domain.StatusOpen,
 					}, nil
 				}
-				prRepo.MergeFunc = func(ctx context.Context, prID string, mergedAt time.Time) error {
-					return nil
+				teamRepo.GetByUserIDFunc = func(ctx context.Context, userID string) (*domain.Team, error) {
+					return &domain.Team{Name: "team-1"}, nil
+				}
+				prRepo.MergeFunc = func(
+					ctx context.Context,
+					prID string,
+					mergedAt time.Time,
+					mergedBy string,
+					settings *domain.TeamSettings,
+					override *domain.MergeOverride,
+				) (*domain.MergeOverride, error) {
+					return override, nil
 				}
 			},
 		},
 		{
 			name: "PR not found",
-			setupMocks: func(prRepo *MockPRRepository) {
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {
 				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
 					return nil, repository.ErrPRNotFound
 				}
 			},
 			expectedError: domain.NewError(domain.ErrCodeNotFound, "resource not found"),
 		},
+		{
+			name: "PR status changed concurrently",
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return &domain.PullRequest{ID: prID, Status: domain.StatusOpen}, nil
+				}
+				teamRepo.GetByUserIDFunc = func(ctx context.Context, userID string) (*domain.Team, error) {
+					return &domain.Team{Name: "team-1"}, nil
+				}
+				prRepo.MergeFunc = func(
+					ctx context.Context,
+					prID string,
+					mergedAt time.Time,
+					mergedBy string,
+					settings *domain.TeamSettings,
+					override *domain.MergeOverride,
+				) (*domain.MergeOverride, error) {
+					return nil, repository.ErrPRStatusChanged
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeInvalidTransition, "PR status changed concurrently"),
+		},
+		{
+			name: "enough approvals",
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return &domain.PullRequest{ID: prID, Status: domain.StatusOpen, Reviewers: approved("u2", "u3")}, nil
+				}
+				teamRepo.GetByUserIDFunc = func(ctx context.Context, userID string) (*domain.Team, error) {
+					return teamWithApprovals(2), nil
+				}
+			},
+		},
+		{
+			name: "not enough approvals",
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return &domain.PullRequest{ID: prID, Status: domain.StatusOpen, Reviewers: approved("u2")}, nil
+				}
+				teamRepo.GetByUserIDFunc = func(ctx context.Context, userID string) (*domain.Team, error) {
+					return teamWithApprovals(2), nil
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeMergeBlocked, "merge policy is not satisfied"),
+		},
+		{
+			name: "PR override lowers required approvals",
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {
+				required := 1
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return &domain.PullRequest{
+						ID:                prID,
+						Status:            domain.StatusOpen,
+						Reviewers:         approved("u2"),
+						RequiredApprovals: &required,
+					}, nil
+				}
+				teamRepo.GetByUserIDFunc = func(ctx context.Context, userID string) (*domain.Team, error) {
+					return teamWithApprovals(2), nil
+				}
+			},
+		},
+		{
+			name: "outstanding changes requested",
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return &domain.PullRequest{
+						ID:     prID,
+						Status: domain.StatusOpen,
+						Reviewers: []domain.Reviewer{
+							{UserID: "u2", State: domain.ReviewApproved},
+							{UserID: "u3", State: domain.ReviewChangesRequested},
+						},
+					}, nil
+				}
+				teamRepo.GetByUserIDFunc = func(ctx context.Context, userID string) (*domain.Team, error) {
+					return teamWithApprovals(1), nil
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeMergeBlocked, "merge policy is not satisfied"),
+		},
+		{
+			name: "changes requested before merge is committed",
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return &domain.PullRequest{ID: prID, Status: domain.StatusOpen, Reviewers: approved("u2")}, nil
+				}
+				teamRepo.GetByUserIDFunc = func(ctx context.Context, userID string) (*domain.Team, error) {
+					return teamWithApprovals(1), nil
+				}
+				prRepo.MergeFunc = func(
+					ctx context.Context,
+					prID string,
+					mergedAt time.Time,
+					mergedBy string,
+					settings *domain.TeamSettings,
+					override *domain.MergeOverride,
+				) (*domain.MergeOverride, error) {
+					if settings == nil || settings.RequiredApprovals != 1 {
+						return nil, errors.New("team policy is not passed to merge")
+					}
+					return nil, domain.NewErrorWithDetails(domain.ErrCodeMergeBlocked, "merge policy is not satisfied",
+						[]string{"changes requested by u2"})
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeMergeBlocked, "merge policy is not satisfied"),
+		},
+		{
+			name: "force merge records override",
+			ctx:  admin,
+			opts: domain.MergeOptions{Force: true, Reason: "hotfix"},
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return &domain.PullRequest{ID: prID, Status: domain.StatusOpen}, nil
+				}
+				teamRepo.GetByUserIDFunc = func(ctx context.Context, userID string) (*domain.Team, error) {
+					return teamWithApprovals(2), nil
+				}
+				prRepo.MergeFunc = func(
+					ctx context.Context,
+					prID string,
+					mergedAt time.Time,
+					mergedBy string,
+					settings *domain.TeamSettings,
+					override *domain.MergeOverride,
+				) (*domain.MergeOverride, error) {
+					if override == nil || override.ActorID != "u9" {
+						return nil, errors.New("override is not recorded")
+					}
+					return override, nil
+				}
+			},
+		},
+		{
+			name:          "force merge without reason",
+			ctx:           admin,
+			opts:          domain.MergeOptions{Force: true},
+			setupMocks:    func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "force merge requires reason"),
+		},
+		{
+			name: "force merge by service key",
+			ctx: domain.WithCaller(context.Background(),
+				&domain.Caller{Role: domain.RoleService, KeyID: "ci"}),
+			opts:          domain.MergeOptions{Force: true, Reason: "hotfix"},
+			setupMocks:    func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {},
+			expectedError: domain.NewError(domain.ErrCodeForbidden, "only admin can force merge"),
+		},
+		{
+			name:          "force merge without caller",
+			opts:          domain.MergeOptions{Force: true, Reason: "hotfix"},
+			setupMocks:    func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {},
+			expectedError: domain.NewError(domain.ErrCodeForbidden, "only admin can force merge"),
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			prRepo := &MockPRRepository{}
-			tt.setupMocks(prRepo)
+			teamRepo := &MockTeamRepository{}
+			tt.setupMocks(prRepo, teamRepo)
 
-			service := NewService(prRepo, &MockTeamRepository{}, &MockUserRepository{}, getTestLogger())
-			result, err := service.MergePR(context.Background(), "pr-1")
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			service := NewService(prRepo, teamRepo, &MockUserRepository{}, getTestLogger())
+			result, err := service.MergePR(ctx, "pr-1", tt.opts)
 
 			if tt.expectedError != nil {
 				require.Error(t, err)
 				var domainErr *domain.Error
 				require.True(t, errors.As(err, &domainErr))
 				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				if tt.expectedError.Code == domain.ErrCodeMergeBlocked {
+					assert.NotEmpty(t, domainErr.Details)
+				}
 				assert.Nil(t, result)
 			} else {
 				require.NoError(t, err)
@@ -282,7 +939,15 @@ func TestService_ReassignReviewer(t *testing.T) {
 						},
 					}, nil
 				}
-				prRepo.ChangeReviewerFunc = func(ctx context.Context, prID, oldReviewerID, newReviewerID string) error {
+				prRepo.ChangeReviewerFunc = func(
+					ctx context.Context,
+					prID, oldReviewerID, newReviewerID string,
+					isFallback bool,
+					audit domain.Audit,
+				) error {
+					if audit.Reason == "" {
+						return errors.New("reassignment reason is not recorded")
+					}
 					return nil
 				}
 			},
@@ -299,6 +964,75 @@ func TestService_ReassignReviewer(t *testing.T) {
 			},
 			expectedError: domain.NewError(domain.ErrCodePRMerged, "cannot reassign on merged PR"),
 		},
+		{
+			name: "reviewer without team replaced from author's team",
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return &domain.PullRequest{
+						ID:                prID,
+						Status:            domain.StatusOpen,
+						AuthorID:          "author-1",
+						AssignedReviewers: []string{"reviewer-1", "reviewer-2"},
+					}, nil
+				}
+				active := true
+				authorTeam := &domain.Team{
+					Name: "team-1",
+					Members: []domain.TeamMember{
+						{ID: "author-1", IsActive: &active},
+						{ID: "reviewer-2", IsActive: &active},
+						{ID: "reviewer-3", IsActive: &active},
+					},
+				}
+				teamRepo.GetByUserIDFunc = func(ctx context.Context, userID string) (*domain.Team, error) {
+					if userID == "reviewer-1" {
+						return nil, repository.ErrTeamNotFound
+					}
+					return authorTeam, nil
+				}
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return authorTeam, nil
+				}
+				prRepo.ChangeReviewerFunc = func(
+					ctx context.Context,
+					prID, oldReviewerID, newReviewerID string,
+					isFallback bool,
+					audit domain.Audit,
+				) error {
+					if newReviewerID != "reviewer-3" || isFallback {
+						return errors.New("unexpected replacement")
+					}
+					return nil
+				}
+			},
+		},
+		{
+			name: "unknown reviewer without candidates",
+			setupMocks: func(prRepo *MockPRRepository, teamRepo *MockTeamRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return &domain.PullRequest{
+						ID:                prID,
+						Status:            domain.StatusOpen,
+						AuthorID:          "author-1",
+						AssignedReviewers: []string{"reviewer-1"},
+					}, nil
+				}
+				teamRepo.GetByUserIDFunc = func(ctx context.Context, userID string) (*domain.Team, error) {
+					if userID == "reviewer-1" {
+						return nil, repository.ErrUserNotFound
+					}
+					return &domain.Team{Name: "team-1"}, nil
+				}
+				active := true
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return &domain.Team{
+						Name:    "team-1",
+						Members: []domain.TeamMember{{ID: "author-1", IsActive: &active}},
+					}, nil
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNoCandidate, "no active replacement candidate in team"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -308,7 +1042,7 @@ func TestService_ReassignReviewer(t *testing.T) {
 			tt.setupMocks(prRepo, teamRepo)
 
 			service := NewService(prRepo, teamRepo, &MockUserRepository{}, getTestLogger())
-			result, newReviewerID, err := service.ReassignReviewer(context.Background(), "pr-1", "reviewer-1")
+			result, newReviewerID, err := service.ReassignReviewer(context.Background(), "pr-1", "reviewer-1", domain.Audit{ActorID: "admin"})
 
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -324,3 +1058,295 @@ func TestService_ReassignReviewer(t *testing.T) {
 		})
 	}
 }
+
+func TestService_SubmitReview(t *testing.T) {
+	openPR := func(prID string) *domain.PullRequest {
+		return &domain.PullRequest{
+			ID:                prID,
+			Status:            domain.StatusOpen,
+			AuthorID:          "author-1",
+			AssignedReviewers: []string{"reviewer-1", "reviewer-2"},
+			Reviewers: []domain.Reviewer{
+				{UserID: "reviewer-1", State: domain.ReviewApproved},
+				{UserID: "reviewer-2", State: domain.ReviewPending},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		reviewerID    string
+		state         domain.ReviewState
+		setupMocks    func(prRepo *MockPRRepository)
+		expectedError *domain.Error
+		expectedState domain.ReviewState
+	}{
+		{
+			name:       "approve",
+			reviewerID: "reviewer-2",
+			state:      domain.ReviewApproved,
+			setupMocks: func(prRepo *MockPRRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return openPR(prID), nil
+				}
+			},
+			expectedState: domain.ReviewApproved,
+		},
+		{
+			name:       "comment keeps earlier decision",
+			reviewerID: "reviewer-1",
+			state:      domain.ReviewCommented,
+			setupMocks: func(prRepo *MockPRRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return openPR(prID), nil
+				}
+			},
+			expectedState: domain.ReviewApproved,
+		},
+		{
+			name:       "reviewer not assigned",
+			reviewerID: "reviewer-3",
+			state:      domain.ReviewApproved,
+			setupMocks: func(prRepo *MockPRRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return openPR(prID), nil
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNotAssigned, "reviewer is not assigned to this PR"),
+		},
+		{
+			name:       "PR already merged",
+			reviewerID: "reviewer-1",
+			state:      domain.ReviewApproved,
+			setupMocks: func(prRepo *MockPRRepository) {
+				prRepo.GetByIDFunc = func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					pr := openPR(prID)
+					pr.Status = domain.StatusMerged
+					return pr, nil
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodePRMerged, "cannot review merged PR"),
+		},
+		{
+			name:          "pending is not a review decision",
+			reviewerID:    "reviewer-1",
+			state:         domain.ReviewPending,
+			setupMocks:    func(prRepo *MockPRRepository) {},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "unknown review state"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := &MockPRRepository{}
+			tt.setupMocks(prRepo)
+
+			service := NewService(prRepo, &MockTeamRepository{}, &MockUserRepository{}, getTestLogger())
+			result, err := service.SubmitReview(context.Background(), "pr-1", tt.reviewerID, tt.state, "")
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			for _, reviewer := range result.Reviewers {
+				if reviewer.UserID == tt.reviewerID {
+					assert.Equal(t, tt.expectedState, reviewer.State)
+					assert.NotNil(t, reviewer.ReviewedAt)
+				}
+			}
+		})
+	}
+}
+
+func TestService_GetHistory(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMocks    func(prRepo *MockPRRepository)
+		expectedCount int
+		expectedError *domain.Error
+	}{
+		{
+			name: "PR with events",
+			setupMocks: func(prRepo *MockPRRepository) {
+				prRepo.ExistsFunc = func(ctx context.Context, prID string) (bool, error) {
+					return true, nil
+				}
+				prRepo.GetReviewerEventsFunc = func(ctx context.Context, prID string) ([]domain.ReviewerEvent, error) {
+					return []domain.ReviewerEvent{
+						{ID: 1, PRID: prID, Type: domain.EventAssign, ReviewerID: "reviewer-1"},
+						{ID: 2, PRID: prID, Type: domain.EventReassign, ReviewerID: "reviewer-2", PreviousReviewerID: "reviewer-1"},
+					}, nil
+				}
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "PR not found",
+			setupMocks: func(prRepo *MockPRRepository) {
+				prRepo.ExistsFunc = func(ctx context.Context, prID string) (bool, error) {
+					return false, nil
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNotFound, "resource not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := &MockPRRepository{}
+			tt.setupMocks(prRepo)
+
+			service := NewService(prRepo, &MockTeamRepository{}, &MockUserRepository{}, getTestLogger())
+			events, err := service.GetHistory(context.Background(), "pr-1")
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				assert.Nil(t, events)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, events, tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestActiveMembers_SkipsUnavailable(t *testing.T) {
+	active, inactive := true, false
+	team := &domain.Team{
+		Name: "team-1",
+		Members: []domain.TeamMember{
+			{ID: "user-1", IsActive: &active},
+			{ID: "user-2", IsActive: &inactive},
+			{ID: "user-3", IsActive: &active, IsAbsent: true},
+			{ID: "user-4", IsActive: &active},
+		},
+	}
+
+	members := activeMembers(team, "user-4")
+	require.Len(t, members, 1)
+	assert.Equal(t, "user-1", members[0].ID)
+}
+
+func TestActiveMembers_SkipsArchivedTeam(t *testing.T) {
+	active := true
+	archivedAt := time.Now()
+	team := &domain.Team{
+		Name:       "team-1",
+		Members:    []domain.TeamMember{{ID: "user-1", IsActive: &active}},
+		ArchivedAt: &archivedAt,
+	}
+
+	assert.Empty(t, activeMembers(team))
+}
+
+func TestService_ListPRs(t *testing.T) {
+	from := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		filter        domain.PRFilter
+		listErr       error
+		expectedError *domain.Error
+		validate      func(*testing.T, domain.PRFilter)
+	}{
+		{
+			name:   "defaults applied",
+			filter: domain.PRFilter{Status: domain.StatusOpen},
+			validate: func(t *testing.T, filter domain.PRFilter) {
+				assert.Equal(t, domain.DefaultPageLimit, filter.Limit)
+				assert.Equal(t, domain.PRSortCreatedAt, filter.SortBy)
+				assert.Equal(t, domain.SortDesc, filter.Order)
+			},
+		},
+		{
+			name:          "unknown status",
+			filter:        domain.PRFilter{Status: "REJECTED"},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "unknown status REJECTED"),
+		},
+		{
+			name:          "unknown sort field",
+			filter:        domain.PRFilter{PageRequest: domain.PageRequest{SortBy: "author_id"}},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "unknown sort field author_id"),
+		},
+		{
+			name:          "limit too large",
+			filter:        domain.PRFilter{PageRequest: domain.PageRequest{Limit: domain.MaxPageLimit + 1}},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "limit must be between 1 and 200"),
+		},
+		{
+			name:          "invalid created range",
+			filter:        domain.PRFilter{CreatedFrom: &from, CreatedTo: &to},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "created_from must be before created_to"),
+		},
+		{
+			name:          "invalid cursor",
+			filter:        domain.PRFilter{PageRequest: domain.PageRequest{Cursor: "garbage"}},
+			listErr:       repository.ErrInvalidCursor,
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "invalid cursor"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := &MockPRRepository{
+				ListFunc: func(ctx context.Context, filter domain.PRFilter) ([]domain.PullRequestShort, string, error) {
+					if tt.validate != nil {
+						tt.validate(t, filter)
+					}
+					if tt.listErr != nil {
+						return nil, "", tt.listErr
+					}
+					return []domain.PullRequestShort{{ID: "pr-1"}}, "next", nil
+				},
+			}
+
+			service := NewService(prRepo, &MockTeamRepository{}, &MockUserRepository{}, getTestLogger())
+			prs, next, err := service.ListPRs(context.Background(), tt.filter)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				assert.Equal(t, tt.expectedError.Message, domainErr.Message)
+				assert.Nil(t, prs)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, prs, 1)
+				assert.Equal(t, "next", next)
+			}
+		})
+	}
+}
+
+func TestService_GetPRs(t *testing.T) {
+	prRepo := &MockPRRepository{
+		GetByIDsFunc: func(ctx context.Context, prIDs []string) ([]domain.PullRequest, error) {
+			assert.Equal(t, []string{"pr-2", "pr-9", "pr-1"}, prIDs)
+			return []domain.PullRequest{{ID: "pr-1"}, {ID: "pr-2"}}, nil
+		},
+	}
+	service := NewService(prRepo, &MockTeamRepository{}, &MockUserRepository{}, getTestLogger())
+
+	prs, notFound, err := service.GetPRs(context.Background(), []string{"pr-2", "pr-9", "pr-1", "pr-2"})
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+	assert.Equal(t, "pr-2", prs[0].ID)
+	assert.Equal(t, "pr-1", prs[1].ID)
+	assert.Equal(t, []string{"pr-9"}, notFound)
+
+	_, _, err = service.GetPRs(context.Background(), nil)
+	var domainErr *domain.Error
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domain.ErrCodeBadRequest, domainErr.Code)
+}
@@ -0,0 +1,132 @@
+package pr
+
+import (
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Candidate is a team member that can be assigned as a reviewer
+type Candidate struct {
+	UserID      string
+	OpenReviews int
+	Weight      int
+}
+
+// ReviewerSelector picks up to count reviewers from candidates
+type ReviewerSelector interface {
+	Select(candidates []Candidate, count int) []string
+}
+
+// Round-robin selector depends on the team's stored cursor and is built per selection
+func newSelectors() map[domain.ReviewerStrategy]ReviewerSelector {
+	return map[domain.ReviewerStrategy]ReviewerSelector{
+		domain.StrategyRandom:      &RandomSelector{},
+		domain.StrategyLeastLoaded: &LeastLoadedSelector{},
+		domain.StrategyWeighted:    &WeightedSelector{},
+	}
+}
+
+// RandomSelector picks reviewers uniformly at random
+type RandomSelector struct{}
+
+func (s *RandomSelector) Select(candidates []Candidate, count int) []string {
+	shuffled := shuffleCandidates(candidates)
+	return candidateIDs(shuffled, count)
+}
+
+// LeastLoadedSelector prefers candidates with the fewest open reviews, ties are broken randomly
+type LeastLoadedSelector struct{}
+
+func (s *LeastLoadedSelector) Select(candidates []Candidate, count int) []string {
+	shuffled := shuffleCandidates(candidates)
+	sort.SliceStable(shuffled, func(i, j int) bool {
+		return shuffled[i].OpenReviews < shuffled[j].OpenReviews
+	})
+	return candidateIDs(shuffled, count)
+}
+
+// RoundRobinSelector walks through team members in a stable order starting at the team's cursor
+type RoundRobinSelector struct {
+	Cursor int64
+}
+
+func (s *RoundRobinSelector) Select(candidates []Candidate, count int) []string {
+	if len(candidates) == 0 || count <= 0 {
+		return []string{}
+	}
+
+	ordered := make([]Candidate, len(candidates))
+	copy(ordered, candidates)
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].UserID < ordered[j].UserID
+	})
+
+	if count > len(ordered) {
+		count = len(ordered)
+	}
+
+	start := int(s.Cursor % int64(len(ordered)))
+	reviewers := make([]string, 0, count)
+	for i := range count {
+		reviewers = append(reviewers, ordered[(start+i)%len(ordered)].UserID)
+	}
+	return reviewers
+}
+
+// WeightedSelector picks reviewers randomly with probability proportional to their review weight
+type WeightedSelector struct{}
+
+func (s *WeightedSelector) Select(candidates []Candidate, count int) []string {
+	type keyed struct {
+		candidate Candidate
+		key       float64
+	}
+
+	// Weighted sampling without replacement (Efraimidis-Spirakis)
+	keys := make([]keyed, 0, len(candidates))
+	for _, c := range candidates {
+		weight := c.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		keys = append(keys, keyed{
+			candidate: c,
+			key:       math.Pow(rand.Float64(), 1/float64(weight)),
+		})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].key > keys[j].key
+	})
+
+	ordered := make([]Candidate, 0, len(keys))
+	for _, k := range keys {
+		ordered = append(ordered, k.candidate)
+	}
+	return candidateIDs(ordered, count)
+}
+
+func shuffleCandidates(candidates []Candidate) []Candidate {
+	shuffled := make([]Candidate, len(candidates))
+	copy(shuffled, candidates)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+func candidateIDs(candidates []Candidate, maxCount int) []string {
+	if maxCount < 0 {
+		maxCount = 0
+	}
+	if len(candidates) > maxCount {
+		candidates = candidates[:maxCount]
+	}
+
+	ids := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.UserID)
+	}
+	return ids
+}
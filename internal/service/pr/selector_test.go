@@ -0,0 +1,77 @@
+package pr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomSelector_Select(t *testing.T) {
+	candidates := []Candidate{{UserID: "u1"}, {UserID: "u2"}, {UserID: "u3"}}
+	selector := &RandomSelector{}
+
+	reviewers := selector.Select(candidates, 2)
+	assert.Len(t, reviewers, 2)
+	assert.NotEqual(t, reviewers[0], reviewers[1])
+
+	assert.Len(t, selector.Select(candidates, 5), 3)
+	assert.Empty(t, selector.Select(nil, 2))
+}
+
+func TestLeastLoadedSelector_Select(t *testing.T) {
+	candidates := []Candidate{
+		{UserID: "u1", OpenReviews: 15},
+		{UserID: "u2", OpenReviews: 0},
+		{UserID: "u3", OpenReviews: 3},
+	}
+	selector := &LeastLoadedSelector{}
+
+	reviewers := selector.Select(candidates, 2)
+	assert.Equal(t, []string{"u2", "u3"}, reviewers)
+}
+
+func TestLeastLoadedSelector_BreaksTiesRandomly(t *testing.T) {
+	candidates := []Candidate{
+		{UserID: "u1", OpenReviews: 1},
+		{UserID: "u2", OpenReviews: 1},
+		{UserID: "u3", OpenReviews: 5},
+	}
+	selector := &LeastLoadedSelector{}
+
+	seen := make(map[string]bool)
+	for range 200 {
+		reviewers := selector.Select(candidates, 1)
+		seen[reviewers[0]] = true
+	}
+	assert.True(t, seen["u1"])
+	assert.True(t, seen["u2"])
+	assert.False(t, seen["u3"])
+}
+
+func TestRoundRobinSelector_Select(t *testing.T) {
+	candidates := []Candidate{{UserID: "u3"}, {UserID: "u1"}, {UserID: "u2"}}
+
+	assert.Equal(t, []string{"u1", "u2"}, (&RoundRobinSelector{Cursor: 0}).Select(candidates, 2))
+	assert.Equal(t, []string{"u3", "u1"}, (&RoundRobinSelector{Cursor: 2}).Select(candidates, 2))
+	assert.Equal(t, []string{"u2"}, (&RoundRobinSelector{Cursor: 4}).Select(candidates, 1))
+	assert.Equal(t, []string{"u1", "u2", "u3"}, (&RoundRobinSelector{Cursor: 3}).Select(candidates, 5))
+	assert.Empty(t, (&RoundRobinSelector{Cursor: 1}).Select(nil, 1))
+}
+
+func TestWeightedSelector_Select(t *testing.T) {
+	candidates := []Candidate{
+		{UserID: "heavy", Weight: 100},
+		{UserID: "light", Weight: 1},
+	}
+	selector := &WeightedSelector{}
+
+	heavy := 0
+	for range 500 {
+		if selector.Select(candidates, 1)[0] == "heavy" {
+			heavy++
+		}
+	}
+	assert.Greater(t, heavy, 400)
+
+	assert.ElementsMatch(t, []string{"heavy", "light"}, selector.Select(candidates, 2))
+}
@@ -0,0 +1,314 @@
+package pr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/metrics"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// Allowed PR status transitions
+var transitions = map[domain.PRStatus][]domain.PRStatus{
+	domain.StatusDraft:  {domain.StatusOpen, domain.StatusClosed},
+	domain.StatusOpen:   {domain.StatusMerged, domain.StatusClosed},
+	domain.StatusClosed: {domain.StatusOpen},
+	domain.StatusMerged: {},
+}
+
+func canTransition(from, to domain.PRStatus) bool {
+	for _, status := range transitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Service) checkTransition(pr *domain.PullRequest, to domain.PRStatus) error {
+	if !canTransition(pr.Status, to) {
+		s.log.Warn("invalid PR status transition",
+			slog.String("pr_id", pr.ID),
+			slog.String("from", string(pr.Status)),
+			slog.String("to", string(to)))
+		return domain.NewError(domain.ErrCodeInvalidTransition,
+			fmt.Sprintf("cannot change PR status from %s to %s", pr.Status, to))
+	}
+	return nil
+}
+
+// MarkReady moves draft PR to OPEN and assigns reviewers
+func (s *Service) MarkReady(ctx context.Context, prID string, opts domain.ReviewerOptions) (*domain.PullRequest, error) {
+	pr, err := s.getPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkAuthor(ctx, pr); err != nil {
+		return nil, err
+	}
+	if pr.Status != domain.StatusDraft {
+		s.log.Warn("PR is not a draft", slog.String("pr_id", prID))
+		return nil, domain.NewError(domain.ErrCodeInvalidTransition,
+			fmt.Sprintf("cannot mark %s PR as ready", pr.Status))
+	}
+
+	return s.openPR(ctx, pr, opts, reasonMarkedReady)
+}
+
+// ClosePR abandons PR without merge, its reviews no longer count towards reviewers load
+func (s *Service) ClosePR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	pr, err := s.getPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkTransition(pr, domain.StatusClosed); err != nil {
+		return nil, err
+	}
+	if err = s.checkAuthor(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	audit := domain.Audit{ActorID: domain.ActorID(ctx, "")}
+	if err = s.updateStatus(ctx, pr, domain.StatusClosed, nil, nil, audit); err != nil {
+		return nil, err
+	}
+
+	closedAt := time.Now()
+	pr.Status = domain.StatusClosed
+	pr.ClosedAt = &closedAt
+
+	return pr, nil
+}
+
+// ReopenPR moves closed PR back to OPEN, reviewers are assigned if PR has none.
+// Kept reviewers who are no longer available are replaced or dropped
+func (s *Service) ReopenPR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	pr, err := s.getPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkTransition(pr, domain.StatusOpen); err != nil {
+		return nil, err
+	}
+	if pr.Status != domain.StatusClosed {
+		return nil, domain.NewError(domain.ErrCodeInvalidTransition,
+			fmt.Sprintf("cannot reopen %s PR", pr.Status))
+	}
+	if err = s.checkAuthor(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	if len(pr.AssignedReviewers) == 0 {
+		return s.openPR(ctx, pr, domain.ReviewerOptions{}, reasonReopened)
+	}
+
+	// Reviewers could have left while PR was closed
+	removed, err := s.unavailableReviewers(ctx, pr.AssignedReviewers)
+	if err != nil {
+		return nil, err
+	}
+	var assigned []domain.Reviewer
+	if len(removed) > 0 {
+		assigned, err = s.replaceReviewers(ctx, pr, len(removed))
+		if err != nil {
+			return nil, err
+		}
+		s.log.Info("unavailable reviewers replaced on PR reopen",
+			slog.String("pr_id", pr.ID),
+			slog.Any("removed", removed),
+			slog.Int("assigned", len(assigned)))
+	}
+
+	audit := domain.Audit{ActorID: domain.ActorID(ctx, ""), Reason: reasonReopenReplaced}
+	if err = s.updateStatus(ctx, pr, domain.StatusOpen, assigned, removed, audit); err != nil {
+		return nil, err
+	}
+
+	reviewers := make([]domain.Reviewer, 0, len(pr.Reviewers)+len(assigned))
+	for _, reviewer := range pr.Reviewers {
+		if !slices.Contains(removed, reviewer.UserID) {
+			reviewers = append(reviewers, reviewer)
+		}
+	}
+	reviewers = append(reviewers, assigned...)
+
+	pr.Status = domain.StatusOpen
+	pr.ClosedAt = nil
+	pr.Reviewers = reviewers
+	pr.AssignedReviewers = make([]string, 0, len(reviewers))
+	pr.FallbackReviewers = nil
+	for _, reviewer := range reviewers {
+		pr.AssignedReviewers = append(pr.AssignedReviewers, reviewer.UserID)
+		if reviewer.IsFallback {
+			pr.FallbackReviewers = append(pr.FallbackReviewers, reviewer.UserID)
+		}
+	}
+	if len(reviewers) == 0 {
+		metrics.PRsWithoutReviewers.Inc()
+	}
+
+	return pr, nil
+}
+
+// Reviewers who are deactivated, absent, without a team or in an archived team
+func (s *Service) unavailableReviewers(ctx context.Context, reviewerIDs []string) ([]string, error) {
+	var unavailable []string
+	for _, reviewerID := range reviewerIDs {
+		team, err := s.teamRepo.GetByUserID(ctx, reviewerID)
+		if err != nil {
+			if errors.Is(err, repository.ErrTeamNotFound) || errors.Is(err, repository.ErrUserNotFound) {
+				unavailable = append(unavailable, reviewerID)
+				continue
+			}
+			s.log.Error(err.Error())
+			return nil, fmt.Errorf("failed to get reviewer's team: %w", err)
+		}
+
+		available := slices.ContainsFunc(activeMembers(team), func(member domain.TeamMember) bool {
+			return member.ID == reviewerID
+		})
+		if !available {
+			unavailable = append(unavailable, reviewerID)
+		}
+	}
+	return unavailable, nil
+}
+
+// Pick up to count new reviewers for PR from author's team, then its fallback teams.
+// Author without a team gets no replacements
+func (s *Service) replaceReviewers(ctx context.Context, pr *domain.PullRequest, count int) ([]domain.Reviewer, error) {
+	team, err := s.getPlanAuthorTeam(ctx, pr.AuthorID)
+	if err != nil || team == nil {
+		return nil, err
+	}
+
+	exclude := append([]string{pr.AuthorID}, pr.AssignedReviewers...)
+	selected, err := s.selectReviewers(ctx, team, activeMembers(team, exclude...), count, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var fallbackReviewers []string
+	if len(selected) < count {
+		fallbackReviewers, err = s.selectFallbackReviewers(ctx, team, count-len(selected), nil,
+			append(exclude, selected...)...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return newReviewers(append(selected, fallbackReviewers...), fallbackReviewers, time.Now()), nil
+}
+
+// Assign reviewers and move PR to OPEN
+func (s *Service) openPR(
+	ctx context.Context,
+	pr *domain.PullRequest,
+	opts domain.ReviewerOptions,
+	reason string,
+) (*domain.PullRequest, error) {
+	team, err := s.getAuthorTeam(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateReviewerOptions(team, pr.AuthorID, opts); err != nil {
+		s.log.Warn("invalid reviewer options", slog.String("pr_id", pr.ID), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	reviewers, fallbackReviewers, err := s.assignReviewers(ctx, pr.ID, pr.AuthorID, team, opts)
+	if err != nil {
+		return nil, err
+	}
+	assigned := newReviewers(reviewers, fallbackReviewers, time.Now())
+
+	audit := domain.Audit{ActorID: domain.ActorID(ctx, ""), Reason: reason}
+	if err = s.updateStatus(ctx, pr, domain.StatusOpen, assigned, nil, audit); err != nil {
+		return nil, err
+	}
+	if len(assigned) == 0 {
+		metrics.PRsWithoutReviewers.Inc()
+	}
+
+	pr.Status = domain.StatusOpen
+	pr.ClosedAt = nil
+	pr.AssignedReviewers = reviewers
+	pr.FallbackReviewers = fallbackReviewers
+	pr.Reviewers = assigned
+
+	return pr, nil
+}
+
+func (s *Service) updateStatus(
+	ctx context.Context,
+	pr *domain.PullRequest,
+	to domain.PRStatus,
+	assigned []domain.Reviewer,
+	removed []string,
+	audit domain.Audit,
+) error {
+	err := s.prRepo.UpdateStatus(ctx, pr.ID, pr.Status, to, assigned, removed, audit)
+	if err != nil {
+		if errors.Is(err, repository.ErrPRNotFound) {
+			s.log.Warn("PR not found", slog.String("pr_id", pr.ID))
+			return domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		if errors.Is(err, repository.ErrPRStatusChanged) {
+			s.log.Warn("PR status changed concurrently", slog.String("pr_id", pr.ID))
+			return domain.NewError(domain.ErrCodeInvalidTransition, "PR status changed concurrently")
+		}
+		if errors.Is(err, repository.ErrReviewerChanged) {
+			s.log.Warn("PR reviewers changed concurrently", slog.String("pr_id", pr.ID))
+			return domain.NewError(domain.ErrCodeConcurrentUpdate, "PR reviewers changed concurrently")
+		}
+		s.log.Error(err.Error())
+		return fmt.Errorf("failed to update PR status: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) getPR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	pr, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPRNotFound) {
+			s.log.Warn("PR not found", slog.String("pr_id", prID))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		s.log.Error(err.Error())
+		return nil, fmt.Errorf("failed to get PR: %w", err)
+	}
+	return pr, nil
+}
+
+// Users may manage only their own PRs unless they are admins
+func (s *Service) checkAuthor(ctx context.Context, pr *domain.PullRequest) error {
+	if !domain.CallerFromContext(ctx).CanActAs(pr.AuthorID) {
+		s.log.Warn("caller is not PR author",
+			slog.String("pr_id", pr.ID),
+			slog.String("author_id", pr.AuthorID),
+			slog.String("actor_id", domain.ActorID(ctx, "")))
+		return domain.NewError(domain.ErrCodeForbidden, "only PR author can manage PR")
+	}
+	return nil
+}
+
+// Get team of PR author, authors removed from their team have none
+func (s *Service) getAuthorTeam(ctx context.Context, pr *domain.PullRequest) (*domain.Team, error) {
+	team, err := s.teamRepo.GetByUserID(ctx, pr.AuthorID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			s.log.Warn("PR author has no team",
+				slog.String("pr_id", pr.ID),
+				slog.String("author_id", pr.AuthorID))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "PR author is not a member of any team")
+		}
+		s.log.Error(err.Error())
+		return nil, fmt.Errorf("failed to get author's team: %w", err)
+	}
+	return team, nil
+}
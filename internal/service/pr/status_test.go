@@ -0,0 +1,330 @@
+package pr
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/metrics"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to domain.PRStatus
+		allowed  bool
+	}{
+		{domain.StatusDraft, domain.StatusOpen, true},
+		{domain.StatusDraft, domain.StatusClosed, true},
+		{domain.StatusDraft, domain.StatusMerged, false},
+		{domain.StatusOpen, domain.StatusMerged, true},
+		{domain.StatusOpen, domain.StatusClosed, true},
+		{domain.StatusOpen, domain.StatusDraft, false},
+		{domain.StatusClosed, domain.StatusOpen, true},
+		{domain.StatusClosed, domain.StatusMerged, false},
+		{domain.StatusMerged, domain.StatusOpen, false},
+		{domain.StatusMerged, domain.StatusClosed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			assert.Equal(t, tt.allowed, canTransition(tt.from, tt.to))
+		})
+	}
+}
+
+func TestService_DraftLifecycle(t *testing.T) {
+	active := true
+	team := &domain.Team{
+		Name: "team-1",
+		Members: []domain.TeamMember{
+			{ID: "author-1", IsActive: &active},
+			{ID: "user-2", IsActive: &active},
+			{ID: "user-3", IsActive: &active},
+		},
+	}
+	teamRepo := &MockTeamRepository{
+		GetByUserIDFunc: func(ctx context.Context, userID string) (*domain.Team, error) {
+			return team, nil
+		},
+		GetByNameFunc: func(ctx context.Context, teamName string) (*domain.Team, error) {
+			return team, nil
+		},
+	}
+	userRepo := &MockUserRepository{
+		GetByIDFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			return &domain.User{ID: userID, IsActive: &active}, nil
+		},
+	}
+
+	var stored *domain.PullRequest
+	prRepo := &MockPRRepository{
+		CreateFunc: func(ctx context.Context, pr *domain.PullRequest) error {
+			stored = pr
+			return nil
+		},
+		GetByIDFunc: func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+			return stored, nil
+		},
+		UpdateStatusFunc: func(
+			ctx context.Context,
+			prID string,
+			from, to domain.PRStatus,
+			newReviewers []domain.Reviewer,
+			removedReviewerIDs []string,
+		) error {
+			if stored.Status != from {
+				return errors.New("unexpected status")
+			}
+			return nil
+		},
+	}
+
+	service := NewService(prRepo, teamRepo, userRepo, getTestLogger())
+	ctx := context.Background()
+
+	// Draft gets no reviewers
+	pr, err := service.CreatePullRequest(ctx, "pr-1", "Draft PR", "author-1", true, domain.ReviewerOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusDraft, pr.Status)
+	assert.Empty(t, pr.AssignedReviewers)
+
+	// Draft cannot be merged
+	_, err = service.MergePR(ctx, "pr-1", domain.MergeOptions{})
+	var domainErr *domain.Error
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domain.ErrCodeInvalidTransition, domainErr.Code)
+
+	// Ready assigns reviewers
+	pr, err = service.MarkReady(ctx, "pr-1", domain.ReviewerOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusOpen, pr.Status)
+	assert.ElementsMatch(t, []string{"user-2", "user-3"}, pr.AssignedReviewers)
+
+	// Ready is only for drafts
+	_, err = service.MarkReady(ctx, "pr-1", domain.ReviewerOptions{})
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domain.ErrCodeInvalidTransition, domainErr.Code)
+
+	// Close and reopen keep reviewers
+	pr, err = service.ClosePR(ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusClosed, pr.Status)
+	assert.NotNil(t, pr.ClosedAt)
+
+	_, _, err = service.ReassignReviewer(ctx, "pr-1", "user-2", domain.Audit{})
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domain.ErrCodePRNotOpen, domainErr.Code)
+
+	pr, err = service.ReopenPR(ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusOpen, pr.Status)
+	assert.Nil(t, pr.ClosedAt)
+	assert.Len(t, pr.AssignedReviewers, 2)
+
+	// Open PR cannot be reopened
+	_, err = service.ReopenPR(ctx, "pr-1")
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domain.ErrCodeInvalidTransition, domainErr.Code)
+}
+
+func TestService_CallerOwnership(t *testing.T) {
+	active := true
+	team := &domain.Team{
+		Name: "team-1",
+		Members: []domain.TeamMember{
+			{ID: "author-1", IsActive: &active},
+			{ID: "user-2", IsActive: &active},
+			{ID: "user-3", IsActive: &active},
+		},
+	}
+	teamRepo := &MockTeamRepository{
+		GetByUserIDFunc: func(ctx context.Context, userID string) (*domain.Team, error) {
+			return team, nil
+		},
+	}
+	stored := &domain.PullRequest{
+		ID:                "pr-1",
+		AuthorID:          "author-1",
+		Status:            domain.StatusOpen,
+		AssignedReviewers: []string{"user-2"},
+		Reviewers:         []domain.Reviewer{{UserID: "user-2", State: domain.ReviewPending}},
+	}
+	var mergedBy string
+	prRepo := &MockPRRepository{
+		GetByIDFunc: func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+			return stored, nil
+		},
+		MergeFunc: func(
+			ctx context.Context,
+			prID string,
+			mergedAt time.Time,
+			actorID string,
+			settings *domain.TeamSettings,
+			override *domain.MergeOverride,
+		) (*domain.MergeOverride, error) {
+			mergedBy = actorID
+			return override, nil
+		},
+	}
+
+	service := NewService(prRepo, teamRepo, &MockUserRepository{}, getTestLogger())
+	other := domain.WithCaller(context.Background(), &domain.Caller{UserID: "user-3", Role: domain.RoleService})
+	author := domain.WithCaller(context.Background(), &domain.Caller{UserID: "author-1", Role: domain.RoleService})
+
+	assertForbidden := func(err error) {
+		t.Helper()
+		var domainErr *domain.Error
+		require.True(t, errors.As(err, &domainErr))
+		assert.Equal(t, domain.ErrCodeForbidden, domainErr.Code)
+	}
+
+	_, err := service.CreatePullRequest(other, "pr-2", "PR", "author-1", false, domain.ReviewerOptions{})
+	assertForbidden(err)
+
+	_, err = service.ClosePR(other, "pr-1")
+	assertForbidden(err)
+
+	_, _, err = service.ReassignReviewer(other, "pr-1", "user-2", domain.Audit{})
+	assertForbidden(err)
+
+	_, err = service.SubmitReview(other, "pr-1", "user-2", domain.ReviewApproved, "")
+	assertForbidden(err)
+
+	_, err = service.MergePR(other, "pr-1", domain.MergeOptions{})
+	assertForbidden(err)
+
+	pr, err := service.MergePR(author, "pr-1", domain.MergeOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "author-1", pr.MergedBy)
+	assert.Equal(t, "author-1", mergedBy)
+}
+
+func TestService_MarkReady_CountsPRWithoutReviewers(t *testing.T) {
+	active := true
+	team := &domain.Team{
+		Name:    "team-1",
+		Members: []domain.TeamMember{{ID: "author-1", IsActive: &active}},
+	}
+	teamRepo := &MockTeamRepository{
+		GetByUserIDFunc: func(ctx context.Context, userID string) (*domain.Team, error) {
+			return team, nil
+		},
+		GetByNameFunc: func(ctx context.Context, teamName string) (*domain.Team, error) {
+			return team, nil
+		},
+	}
+	prRepo := &MockPRRepository{
+		GetByIDFunc: func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+			return &domain.PullRequest{ID: prID, AuthorID: "author-1", Status: domain.StatusDraft}, nil
+		},
+	}
+
+	service := NewService(prRepo, teamRepo, &MockUserRepository{}, getTestLogger())
+	before := testutil.ToFloat64(metrics.PRsWithoutReviewers)
+
+	pr, err := service.MarkReady(context.Background(), "pr-1", domain.ReviewerOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pr.AssignedReviewers)
+	assert.Equal(t, before+1, testutil.ToFloat64(metrics.PRsWithoutReviewers))
+}
+
+func TestService_ReopenPR_ReplacesUnavailableReviewers(t *testing.T) {
+	active, inactive := true, false
+	team := &domain.Team{
+		Name: "team-1",
+		Members: []domain.TeamMember{
+			{ID: "author-1", IsActive: &active},
+			{ID: "user-2", IsActive: &inactive},
+			{ID: "user-3", IsActive: &active},
+			{ID: "user-4", IsActive: &active},
+			{ID: "user-5", IsActive: &active, IsAbsent: true},
+		},
+	}
+	closed := func() *domain.PullRequest {
+		return &domain.PullRequest{
+			ID:                "pr-1",
+			AuthorID:          "author-1",
+			Status:            domain.StatusClosed,
+			AssignedReviewers: []string{"user-2", "user-3", "user-6"},
+			Reviewers: []domain.Reviewer{
+				{UserID: "user-2", State: domain.ReviewPending},
+				{UserID: "user-3", State: domain.ReviewApproved},
+				{UserID: "user-6", State: domain.ReviewPending},
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		members           []domain.TeamMember
+		expectedReviewers []string
+		expectedAssigned  []string
+	}{
+		{
+			// Absent user-5 is not a candidate, second replacement is dropped
+			name:              "unavailable reviewers replaced",
+			members:           team.Members,
+			expectedReviewers: []string{"user-3", "user-4"},
+			expectedAssigned:  []string{"user-4"},
+		},
+		{
+			name:              "unavailable reviewers dropped without candidates",
+			members:           team.Members[:3],
+			expectedReviewers: []string{"user-3"},
+			expectedAssigned:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := &domain.Team{Name: team.Name, Members: tt.members}
+			teamRepo := &MockTeamRepository{
+				GetByUserIDFunc: func(ctx context.Context, userID string) (*domain.Team, error) {
+					// user-6 was removed from the team
+					if userID == "user-6" {
+						return nil, repository.ErrTeamNotFound
+					}
+					return current, nil
+				},
+			}
+
+			var removed []string
+			var assigned []string
+			prRepo := &MockPRRepository{
+				GetByIDFunc: func(ctx context.Context, prID string) (*domain.PullRequest, error) {
+					return closed(), nil
+				},
+				UpdateStatusFunc: func(
+					ctx context.Context,
+					prID string,
+					from, to domain.PRStatus,
+					newReviewers []domain.Reviewer,
+					removedReviewerIDs []string,
+				) error {
+					removed = removedReviewerIDs
+					assigned = []string{}
+					for _, reviewer := range newReviewers {
+						assigned = append(assigned, reviewer.UserID)
+					}
+					return nil
+				},
+			}
+
+			service := NewService(prRepo, teamRepo, &MockUserRepository{}, getTestLogger())
+			pr, err := service.ReopenPR(context.Background(), "pr-1")
+			require.NoError(t, err)
+
+			assert.Equal(t, domain.StatusOpen, pr.Status)
+			assert.Equal(t, []string{"user-2", "user-6"}, removed)
+			assert.Equal(t, tt.expectedAssigned, assigned)
+			assert.Equal(t, tt.expectedReviewers, pr.AssignedReviewers)
+			assert.Equal(t, domain.ReviewApproved, pr.Reviewers[0].State)
+		})
+	}
+}
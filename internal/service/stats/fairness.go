@@ -0,0 +1,161 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"log/slog"
+	"math"
+	"sort"
+)
+
+// GetFairness reports distribution of assignments across active members of each team within the window
+func (s *Service) GetFairness(ctx context.Context, filter domain.StatsFilter) ([]domain.TeamFairness, error) {
+	if filter.GroupBy != "" {
+		return nil, domain.NewError(domain.ErrCodeBadRequest, "group_by is not supported for fairness stats")
+	}
+	if err := validateFilter(&filter); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.fairnessTeams(ctx, filter.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.prRepo.GetReviewerStats(ctx, filter)
+	if err != nil {
+		s.log.Error(err.Error())
+		return nil, fmt.Errorf("failed to get reviewer assignments stats: %w", err)
+	}
+	counts := make(map[string]int, len(stats))
+	for _, stat := range stats {
+		counts[stat.UserID] += stat.AssignedCount
+	}
+
+	report := make([]domain.TeamFairness, 0, len(teams))
+	for _, team := range teams {
+		report = append(report, teamFairness(team, counts))
+	}
+
+	return report, nil
+}
+
+// Load the requested team or all active teams
+func (s *Service) fairnessTeams(ctx context.Context, teamName string) ([]*domain.Team, error) {
+	if teamName != "" {
+		team, err := s.teamRepo.GetByName(ctx, teamName)
+		if err != nil {
+			if errors.Is(err, repository.ErrTeamNotFound) {
+				s.log.Warn("team not found", slog.String("team_name", teamName))
+				return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+			}
+			s.log.Error("failed to get team", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("failed to get team: %w", err)
+		}
+		return []*domain.Team{team}, nil
+	}
+
+	archived := false
+	filter := domain.TeamFilter{
+		Archived: &archived,
+		PageRequest: domain.PageRequest{
+			Limit:  domain.MaxPageLimit,
+			SortBy: domain.TeamSortName,
+			Order:  domain.SortAsc,
+		},
+	}
+
+	var teams []*domain.Team
+	for {
+		summaries, next, err := s.teamRepo.List(ctx, filter)
+		if err != nil {
+			s.log.Error("failed to list teams", slog.String("error", err.Error()))
+			return nil, fmt.Errorf("failed to list teams: %w", err)
+		}
+
+		for _, summary := range summaries {
+			team, err := s.teamRepo.GetByName(ctx, summary.Name)
+			if err != nil {
+				// Team deleted after listing
+				if errors.Is(err, repository.ErrTeamNotFound) {
+					continue
+				}
+				s.log.Error("failed to get team", slog.String("error", err.Error()))
+				return nil, fmt.Errorf("failed to get team: %w", err)
+			}
+			teams = append(teams, team)
+		}
+
+		if next == "" {
+			return teams, nil
+		}
+		filter.Cursor = next
+	}
+}
+
+// Compute distribution metrics over active members, members without assignments count as zero
+func teamFairness(team *domain.Team, counts map[string]int) domain.TeamFairness {
+	fairness := domain.TeamFairness{
+		TeamName:         team.Name,
+		ReviewerStrategy: domain.DefaultReviewerStrategy,
+		Members:          make([]domain.ReviewerStat, 0, len(team.Members)),
+	}
+	if team.Settings != nil && team.Settings.ReviewerStrategy != "" {
+		fairness.ReviewerStrategy = team.Settings.ReviewerStrategy
+	}
+
+	for _, member := range team.Members {
+		if member.IsActive == nil || !*member.IsActive {
+			continue
+		}
+		fairness.Members = append(fairness.Members, domain.ReviewerStat{
+			UserID:        member.ID,
+			AssignedCount: counts[member.ID],
+		})
+		fairness.TotalAssignments += counts[member.ID]
+	}
+	sort.Slice(fairness.Members, func(i, j int) bool {
+		if fairness.Members[i].AssignedCount != fairness.Members[j].AssignedCount {
+			return fairness.Members[i].AssignedCount > fairness.Members[j].AssignedCount
+		}
+		return fairness.Members[i].UserID < fairness.Members[j].UserID
+	})
+
+	n := len(fairness.Members)
+	fairness.ActiveMembers = n
+	if n == 0 {
+		return fairness
+	}
+	fairness.Mean = float64(fairness.TotalAssignments) / float64(n)
+
+	var variance float64
+	for _, m := range fairness.Members {
+		d := float64(m.AssignedCount) - fairness.Mean
+		variance += d * d
+	}
+	fairness.StdDev = math.Sqrt(variance / float64(n))
+
+	if fairness.TotalAssignments == 0 {
+		return fairness
+	}
+
+	// Members are sorted in descending order, so i-th member has rank n-i in ascending order:
+	// G = sum((2*rank - n - 1) * x) / (n * sum(x))
+	var weighted float64
+	for i, m := range fairness.Members {
+		rank := n - i
+		weighted += float64(2*rank-n-1) * float64(m.AssignedCount)
+	}
+	fairness.Gini = weighted / float64(n*fairness.TotalAssignments)
+
+	maxCount, minCount := fairness.Members[0].AssignedCount, fairness.Members[n-1].AssignedCount
+	if minCount > 0 {
+		ratio := float64(maxCount) / float64(minCount)
+		fairness.MaxMinRatio = &ratio
+	}
+
+	return fairness
+}
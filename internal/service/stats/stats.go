@@ -9,22 +9,29 @@ import (
 )
 
 type Service struct {
-	prRepo repository.PRRepository
-	log    *slog.Logger
+	prRepo   repository.PRRepository
+	teamRepo repository.TeamRepository
+	log      *slog.Logger
 }
 
 func NewService(
 	prRepo repository.PRRepository,
+	teamRepo repository.TeamRepository,
 	log *slog.Logger,
 ) *Service {
 	return &Service{
-		prRepo: prRepo,
-		log:    log,
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		log:      log,
 	}
 }
 
-func (s *Service) GetReviewerAssignmentsStats(ctx context.Context) ([]domain.ReviewerStat, error) {
-	stats, err := s.prRepo.GetReviewerStats(ctx)
+func (s *Service) GetReviewerAssignmentsStats(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewerStat, error) {
+	if err := validateFilter(&filter); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.prRepo.GetReviewerStats(ctx, filter)
 	if err != nil {
 		s.log.Error(err.Error())
 		return nil, fmt.Errorf("failed to get reviewer assignments stats: %w", err)
@@ -37,8 +44,12 @@ func (s *Service) GetReviewerAssignmentsStats(ctx context.Context) ([]domain.Rev
 	return stats, nil
 }
 
-func (s *Service) GetPRStats(ctx context.Context) ([]domain.PullRequestStat, error) {
-	stats, err := s.prRepo.GetRRStats(ctx)
+func (s *Service) GetPRStats(ctx context.Context, filter domain.StatsFilter) ([]domain.PullRequestStat, error) {
+	if err := validateFilter(&filter); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.prRepo.GetRRStats(ctx, filter)
 	if err != nil {
 		s.log.Error(err.Error())
 		return nil, fmt.Errorf("failed to get PR stats: %w", err)
@@ -50,3 +61,37 @@ func (s *Service) GetPRStats(ctx context.Context) ([]domain.PullRequestStat, err
 
 	return stats, nil
 }
+
+// GetLatencyStats reports review and merge latency percentiles overall, by author's team and by reviewer
+func (s *Service) GetLatencyStats(ctx context.Context, filter domain.StatsFilter) (*domain.LatencyReport, error) {
+	if filter.GroupBy != "" {
+		return nil, domain.NewError(domain.ErrCodeBadRequest, "group_by is not supported for latency stats")
+	}
+	if err := validateFilter(&filter); err != nil {
+		return nil, err
+	}
+
+	report, err := s.prRepo.GetLatencyStats(ctx, filter)
+	if err != nil {
+		s.log.Error(err.Error())
+		return nil, fmt.Errorf("failed to get latency stats: %w", err)
+	}
+
+	return report, nil
+}
+
+func validateFilter(filter *domain.StatsFilter) error {
+	if filter.DateField == "" {
+		filter.DateField = domain.StatsByCreatedAt
+	}
+	if filter.DateField != domain.StatsByCreatedAt && filter.DateField != domain.StatsByMergedAt {
+		return domain.NewError(domain.ErrCodeBadRequest, "date_field must be created_at or merged_at")
+	}
+	if filter.GroupBy != "" && !filter.GroupBy.IsValid() {
+		return domain.NewError(domain.ErrCodeBadRequest, "group_by must be day, week or month")
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return domain.NewError(domain.ErrCodeBadRequest, "from must be before to")
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamFairness(t *testing.T) {
+	active, inactive := true, false
+	team := &domain.Team{
+		Name: "backend",
+		Members: []domain.TeamMember{
+			{ID: "u1", IsActive: &active},
+			{ID: "u2", IsActive: &active},
+			{ID: "u3", IsActive: &active},
+			{ID: "u4", IsActive: &inactive},
+		},
+		Settings: &domain.TeamSettings{ReviewerStrategy: domain.StrategyRandom},
+	}
+
+	tests := []struct {
+		name      string
+		counts    map[string]int
+		wantGini  float64
+		wantStd   float64
+		wantRatio *float64
+		wantOrder []string
+	}{
+		{
+			name:      "even distribution",
+			counts:    map[string]int{"u1": 4, "u2": 4, "u3": 4, "u4": 10},
+			wantGini:  0,
+			wantStd:   0,
+			wantRatio: ptr(1.0),
+			wantOrder: []string{"u1", "u2", "u3"},
+		},
+		{
+			name:      "single overloaded member",
+			counts:    map[string]int{"u2": 9},
+			wantGini:  2.0 / 3,
+			wantStd:   4.2426,
+			wantRatio: nil,
+			wantOrder: []string{"u2", "u1", "u3"},
+		},
+		{
+			name:      "uneven distribution",
+			counts:    map[string]int{"u1": 1, "u2": 2, "u3": 3},
+			wantGini:  2.0 / 9,
+			wantStd:   0.8165,
+			wantRatio: ptr(3.0),
+			wantOrder: []string{"u3", "u2", "u1"},
+		},
+		{
+			name:      "no assignments",
+			counts:    map[string]int{},
+			wantGini:  0,
+			wantStd:   0,
+			wantRatio: nil,
+			wantOrder: []string{"u1", "u2", "u3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := teamFairness(team, tt.counts)
+
+			assert.Equal(t, "backend", got.TeamName)
+			assert.Equal(t, domain.StrategyRandom, got.ReviewerStrategy)
+			assert.Equal(t, 3, got.ActiveMembers)
+			assert.InDelta(t, tt.wantGini, got.Gini, 1e-4)
+			assert.InDelta(t, tt.wantStd, got.StdDev, 1e-4)
+			if tt.wantRatio == nil {
+				assert.Nil(t, got.MaxMinRatio)
+			} else {
+				require.NotNil(t, got.MaxMinRatio)
+				assert.InDelta(t, *tt.wantRatio, *got.MaxMinRatio, 1e-4)
+			}
+
+			order := make([]string, 0, len(got.Members))
+			for _, m := range got.Members {
+				order = append(order, m.UserID)
+			}
+			assert.Equal(t, tt.wantOrder, order)
+		})
+	}
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
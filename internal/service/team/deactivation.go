@@ -0,0 +1,140 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/metrics"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"log/slog"
+)
+
+// Reason recorded for reviews moved away from deactivated members
+const reasonDeactivated = "reviewer deactivated"
+
+// ReviewPlanner plans redistribution of open reviews of leaving team members
+type ReviewPlanner interface {
+	PlanReassignments(ctx context.Context, team *domain.Team, userIDs []string) (*domain.ReassignmentReport, error)
+}
+
+// DeactivateUsers deactivates team members and redistributes their open reviews in one transaction
+func (s *Service) DeactivateUsers(
+	ctx context.Context,
+	teamName string,
+	userIDs []string,
+	audit domain.Audit,
+) (*domain.DeactivationReport, error) {
+	if len(userIDs) == 0 {
+		return nil, domain.NewError(domain.ErrCodeBadRequest, "user_ids must not be empty")
+	}
+
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			s.log.Warn("team not found", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		s.log.Error("failed to get team", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	// Validate that users are unique members of the team
+	members := make(map[string]bool, len(team.Members))
+	for _, member := range team.Members {
+		members[member.ID] = true
+	}
+	seen := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		if seen[userID] {
+			return nil, domain.NewError(domain.ErrCodeBadRequest, "duplicate user")
+		}
+		seen[userID] = true
+		if !members[userID] {
+			s.log.Warn("user is not a team member",
+				slog.String("team_name", teamName),
+				slog.String("user_id", userID))
+			return nil, domain.NewError(domain.ErrCodeNotFound,
+				fmt.Sprintf("user %s is not a member of team %s", userID, teamName))
+		}
+	}
+
+	plan, err := s.planner.PlanReassignments(ctx, team, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	audit.ActorID = domain.ActorID(ctx, audit.ActorID)
+	if audit.Reason == "" {
+		audit.Reason = reasonDeactivated
+	}
+	err = s.teamRepo.DeactivateMembers(ctx, teamName, userIDs, plan.Reassigned, audit)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			s.log.Warn("team members changed concurrently", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeConcurrentUpdate, "team members changed concurrently")
+		}
+		if errors.Is(err, repository.ErrReviewerChanged) {
+			s.log.Warn("PR reviewers changed concurrently", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeConcurrentUpdate, "PR reviewers changed concurrently")
+		}
+		s.log.Error("failed to deactivate users", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to deactivate users: %w", err)
+	}
+	metrics.Reassignments.Add(float64(len(plan.Reassigned)))
+
+	return newDeactivationReport(teamName, userIDs, plan), nil
+}
+
+// Group planned reassignments by user and by PR
+func newDeactivationReport(teamName string, userIDs []string, plan *domain.ReassignmentReport) *domain.DeactivationReport {
+	users := make([]domain.UserReassignment, 0, len(userIDs))
+	userIdx := make(map[string]int, len(userIDs))
+	for i, userID := range userIDs {
+		users = append(users, domain.UserReassignment{
+			UserID: userID,
+			ReassignmentReport: domain.ReassignmentReport{
+				Reassigned: []domain.ReviewReassignment{},
+				Failed:     []domain.FailedReassignment{},
+			},
+		})
+		userIdx[userID] = i
+	}
+
+	prs := make([]domain.PRReassignment, 0)
+	prIdx := make(map[string]int)
+	prReport := func(prID string) *domain.PRReassignment {
+		i, ok := prIdx[prID]
+		if !ok {
+			prs = append(prs, domain.PRReassignment{
+				PRID: prID,
+				ReassignmentReport: domain.ReassignmentReport{
+					Reassigned: []domain.ReviewReassignment{},
+					Failed:     []domain.FailedReassignment{},
+				},
+			})
+			i = len(prs) - 1
+			prIdx[prID] = i
+		}
+		return &prs[i]
+	}
+
+	for _, reassignment := range plan.Reassigned {
+		user := &users[userIdx[reassignment.OldReviewerID]]
+		user.Reassigned = append(user.Reassigned, reassignment)
+		pr := prReport(reassignment.PRID)
+		pr.Reassigned = append(pr.Reassigned, reassignment)
+	}
+	for _, failed := range plan.Failed {
+		user := &users[userIdx[failed.ReviewerID]]
+		user.Failed = append(user.Failed, failed)
+		pr := prReport(failed.PRID)
+		pr.Failed = append(pr.Failed, failed)
+	}
+
+	return &domain.DeactivationReport{
+		TeamName:     teamName,
+		Users:        users,
+		PullRequests: prs,
+	}
+}
@@ -8,4 +8,16 @@ import (
 type ServiceInterface interface {
 	CreateTeam(ctx context.Context, team *domain.Team) error
 	GetTeam(ctx context.Context, teamName string) (*domain.Team, error)
+	ListTeams(ctx context.Context, filter domain.TeamFilter) ([]domain.TeamSummary, string, error)
+	UpdateSettings(ctx context.Context, teamName string, patch *domain.TeamSettingsPatch) (*domain.TeamSettings, error)
+	DeleteTeam(ctx context.Context, teamName string, mode domain.TeamDeleteMode) (*domain.Team, error)
+	AddMembers(ctx context.Context, teamName string, members []domain.TeamMember) (*domain.Team, error)
+	RemoveMember(ctx context.Context, teamName, userID string, audit domain.Audit) (*domain.ReassignmentReport, error)
+	MoveMember(ctx context.Context, userID, fromTeam, toTeam string) (*domain.Team, error)
+	DeactivateUsers(
+		ctx context.Context,
+		teamName string,
+		userIDs []string,
+		audit domain.Audit,
+	) (*domain.DeactivationReport, error)
 }
@@ -0,0 +1,151 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/metrics"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"log/slog"
+)
+
+// Reason recorded for reviews moved away from removed members
+const reasonRemoved = "reviewer removed from team"
+
+// AddMembers adds new or teamless users to an existing team, members of other teams must be moved explicitly
+func (s *Service) AddMembers(ctx context.Context, teamName string, members []domain.TeamMember) (*domain.Team, error) {
+	if len(members) == 0 {
+		return nil, domain.NewError(domain.ErrCodeBadRequest, "members must not be empty")
+	}
+
+	// Validate unique users
+	userIDs := make(map[string]bool, len(members))
+	for i := range members {
+		if userIDs[members[i].ID] {
+			return nil, domain.NewError(domain.ErrCodeBadRequest, "duplicate user")
+		}
+		userIDs[members[i].ID] = true
+
+		if members[i].ReviewWeight <= 0 {
+			members[i].ReviewWeight = 1
+		}
+	}
+
+	err := s.teamRepo.AddMembers(ctx, teamName, members, domain.Audit{ActorID: domain.ActorID(ctx, "")})
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			s.log.Warn("team not found", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		if errors.Is(err, repository.ErrTeamArchived) {
+			s.log.Warn("team is archived", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeTeamArchived, "team is archived")
+		}
+		if errors.Is(err, repository.ErrUserInAnotherTeam) {
+			s.log.Warn("user belongs to another team", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeUserInAnotherTeam,
+				"user belongs to another team, use /team/moveMember")
+		}
+		s.log.Error("failed to add team members", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to add team members: %w", err)
+	}
+
+	return s.GetTeam(ctx, teamName)
+}
+
+// RemoveMember detaches user from the team. Open reviews are moved to the remaining members,
+// reviews without a replacement are unassigned. Users with draft or open authored PRs cannot be removed
+func (s *Service) RemoveMember(
+	ctx context.Context,
+	teamName, userID string,
+	audit domain.Audit,
+) (*domain.ReassignmentReport, error) {
+	team, err := s.teamRepo.GetByName(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			s.log.Warn("team not found", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		s.log.Error("failed to get team", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	if !isMember(team, userID) {
+		s.log.Warn("user is not a team member",
+			slog.String("team_name", teamName),
+			slog.String("user_id", userID))
+		return nil, domain.NewError(domain.ErrCodeNotFound,
+			fmt.Sprintf("user %s is not a member of team %s", userID, teamName))
+	}
+
+	plan, err := s.planner.PlanReassignments(ctx, team, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.ActorID = domain.ActorID(ctx, audit.ActorID)
+	if audit.Reason == "" {
+		audit.Reason = reasonRemoved
+	}
+	err = s.teamRepo.RemoveMember(ctx, teamName, userID, plan.Reassigned, audit)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserHasOpenPRs) {
+			s.log.Warn("user has open PRs", slog.String("user_id", userID))
+			return nil, domain.NewError(domain.ErrCodeUserHasOpenPRs,
+				"user has draft or open PRs, merge or close them first")
+		}
+		if errors.Is(err, repository.ErrUserNotFound) {
+			s.log.Warn("team members changed concurrently", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeConcurrentUpdate, "team members changed concurrently")
+		}
+		if errors.Is(err, repository.ErrReviewerChanged) {
+			s.log.Warn("PR reviewers changed concurrently", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeConcurrentUpdate, "PR reviewers changed concurrently")
+		}
+		s.log.Error("failed to remove team member", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to remove team member: %w", err)
+	}
+	metrics.Reassignments.Add(float64(len(plan.Reassigned)))
+
+	return plan, nil
+}
+
+// MoveMember moves user to another team. Open reviews stay assigned and authored PRs follow the user
+func (s *Service) MoveMember(ctx context.Context, userID, fromTeam, toTeam string) (*domain.Team, error) {
+	if fromTeam == toTeam {
+		return nil, domain.NewError(domain.ErrCodeBadRequest, "from_team and to_team must differ")
+	}
+
+	err := s.teamRepo.MoveMember(ctx, userID, fromTeam, toTeam, domain.Audit{ActorID: domain.ActorID(ctx, "")})
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			s.log.Warn("team not found", slog.String("team_name", toTeam))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		if errors.Is(err, repository.ErrTeamArchived) {
+			s.log.Warn("team is archived", slog.String("team_name", toTeam))
+			return nil, domain.NewError(domain.ErrCodeTeamArchived, "team is archived")
+		}
+		if errors.Is(err, repository.ErrUserNotFound) {
+			s.log.Warn("user is not a team member",
+				slog.String("team_name", fromTeam),
+				slog.String("user_id", userID))
+			return nil, domain.NewError(domain.ErrCodeNotFound,
+				fmt.Sprintf("user %s is not a member of team %s", userID, fromTeam))
+		}
+		s.log.Error("failed to move team member", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to move team member: %w", err)
+	}
+
+	return s.GetTeam(ctx, toTeam)
+}
+
+func isMember(team *domain.Team, userID string) bool {
+	for _, member := range team.Members {
+		if member.ID == userID {
+			return true
+		}
+	}
+	return false
+}
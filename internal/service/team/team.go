@@ -7,19 +7,23 @@ import (
 	"github.com/platonso/avito-pr-service/internal/domain"
 	"github.com/platonso/avito-pr-service/internal/repository"
 	"log/slog"
+	"time"
 )
 
 type Service struct {
 	log      *slog.Logger
 	teamRepo repository.TeamRepository
+	planner  ReviewPlanner
 }
 
 func NewService(
 	teamRepo repository.TeamRepository,
+	planner ReviewPlanner,
 	log *slog.Logger,
 ) *Service {
 	return &Service{
 		teamRepo: teamRepo,
+		planner:  planner,
 		log:      log,
 	}
 }
@@ -34,12 +38,43 @@ func (s *Service) CreateTeam(ctx context.Context, team *domain.Team) error {
 		userIDs[member.ID] = true
 	}
 
-	err := s.teamRepo.CreateWithMembers(ctx, team)
+	// Teams are created active
+	team.ArchivedAt = nil
+
+	// Apply default settings
+	if team.Settings == nil {
+		team.Settings = domain.DefaultTeamSettings()
+	}
+	if team.Settings.ReviewerStrategy == "" {
+		team.Settings.ReviewerStrategy = domain.DefaultReviewerStrategy
+	}
+	if err := team.Settings.Validate(); err != nil {
+		return err
+	}
+	if err := s.validateFallbackTeams(ctx, team.Name, team.Settings.FallbackTeams); err != nil {
+		return err
+	}
+	for i := range team.Members {
+		if team.Members[i].ReviewWeight <= 0 {
+			team.Members[i].ReviewWeight = 1
+		}
+	}
+
+	err := s.teamRepo.CreateWithMembers(ctx, team, domain.Audit{ActorID: domain.ActorID(ctx, "")})
 	if err != nil {
 		if errors.Is(err, repository.ErrTeamAlreadyExists) {
 			s.log.Warn("team already exists", slog.String("team_name", team.Name))
 			return domain.NewError(domain.ErrCodeTeamExists, "team_name already exists")
 		}
+		if errors.Is(err, repository.ErrUserInAnotherTeam) {
+			s.log.Warn("user belongs to another team", slog.String("team_name", team.Name))
+			return domain.NewError(domain.ErrCodeUserInAnotherTeam,
+				"user belongs to another team, use /team/moveMember")
+		}
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			s.log.Warn("fallback team not found", slog.String("team_name", team.Name))
+			return domain.NewError(domain.ErrCodeBadRequest, "fallback team not found")
+		}
 
 		s.log.Error("failed to create team", slog.String("error", err.Error()))
 		return fmt.Errorf("failed to create team: %w", err)
@@ -60,3 +95,134 @@ func (s *Service) GetTeam(ctx context.Context, teamName string) (*domain.Team, e
 	}
 	return team, nil
 }
+
+// UpdateSettings applies patch to the current team settings in one transaction, concurrent patches are not lost
+func (s *Service) UpdateSettings(ctx context.Context, teamName string, patch *domain.TeamSettingsPatch) (*domain.TeamSettings, error) {
+	if patch.FallbackTeams != nil {
+		if err := s.validateFallbackTeams(ctx, teamName, patch.FallbackTeams); err != nil {
+			return nil, err
+		}
+	}
+
+	settings, err := s.teamRepo.UpdateSettings(ctx, teamName, patch)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			s.log.Warn("team not found", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		var domainErr *domain.Error
+		if errors.As(err, &domainErr) {
+			s.log.Warn("invalid team settings",
+				slog.String("team_name", teamName),
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		s.log.Error("failed to update team settings", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to update team settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// ListTeams returns a page of teams with member counts ordered by name
+func (s *Service) ListTeams(ctx context.Context, filter domain.TeamFilter) ([]domain.TeamSummary, string, error) {
+	err := filter.Normalize(domain.TeamSortName, domain.SortAsc, domain.TeamSortName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	teams, next, err := s.teamRepo.List(ctx, filter)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, "", domain.NewError(domain.ErrCodeBadRequest, "invalid cursor")
+		}
+		s.log.Error("failed to list teams", slog.String("error", err.Error()))
+		return nil, "", fmt.Errorf("failed to list teams: %w", err)
+	}
+
+	return teams, next, nil
+}
+
+// DeleteTeam archives the team or removes it permanently. Archived team keeps its history
+// and members but is excluded from reviewer assignment. Hard delete requires a team without users and open PRs
+func (s *Service) DeleteTeam(ctx context.Context, teamName string, mode domain.TeamDeleteMode) (*domain.Team, error) {
+	switch mode {
+	case domain.TeamDeleteArchive:
+		return s.archiveTeam(ctx, teamName)
+	case domain.TeamDeleteHard:
+	default:
+		return nil, domain.NewError(domain.ErrCodeBadRequest, "unknown delete mode")
+	}
+
+	err := s.teamRepo.Delete(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			s.log.Warn("team not found", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		if errors.Is(err, repository.ErrTeamHasOpenPRs) {
+			s.log.Warn("team has open PRs", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeTeamHasOpenPRs,
+				"team members have draft or open PRs, merge or close them first")
+		}
+		if errors.Is(err, repository.ErrTeamHasMembers) {
+			s.log.Warn("team has members", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeTeamHasMembers,
+				"team has members, remove or move them first")
+		}
+		s.log.Error("failed to delete team", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to delete team: %w", err)
+	}
+
+	return nil, nil
+}
+
+// Archiving an already archived team keeps the original archival time
+func (s *Service) archiveTeam(ctx context.Context, teamName string) (*domain.Team, error) {
+	team, err := s.GetTeam(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if team.IsArchived() {
+		return team, nil
+	}
+
+	archivedAt := time.Now()
+	err = s.teamRepo.Archive(ctx, teamName, archivedAt)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			s.log.Warn("team not found", slog.String("team_name", teamName))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		s.log.Error("failed to archive team", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to archive team: %w", err)
+	}
+
+	team.ArchivedAt = &archivedAt
+	return team, nil
+}
+
+func (s *Service) validateFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) error {
+	seen := make(map[string]bool, len(fallbackTeams))
+	for _, fallbackTeam := range fallbackTeams {
+		if fallbackTeam == teamName {
+			return domain.NewError(domain.ErrCodeBadRequest, "team cannot be its own fallback")
+		}
+		if seen[fallbackTeam] {
+			return domain.NewError(domain.ErrCodeBadRequest, "duplicate fallback team")
+		}
+		seen[fallbackTeam] = true
+
+		exists, err := s.teamRepo.Exists(ctx, fallbackTeam)
+		if err != nil {
+			s.log.Error("failed to check fallback team", slog.String("error", err.Error()))
+			return fmt.Errorf("failed to check fallback team: %w", err)
+		}
+		if !exists {
+			s.log.Warn("fallback team not found", slog.String("team_name", fallbackTeam))
+			return domain.NewError(domain.ErrCodeBadRequest,
+				fmt.Sprintf("fallback team %s not found", fallbackTeam))
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/platonso/avito-pr-service/internal/domain"
 	"github.com/platonso/avito-pr-service/internal/repository"
@@ -14,15 +15,33 @@ import (
 )
 
 type MockTeamRepository struct {
-	CreateWithMembersFunc func(ctx context.Context, team *domain.Team) error
+	CreateWithMembersFunc func(ctx context.Context, team *domain.Team, audit domain.Audit) error
 	GetByNameFunc         func(ctx context.Context, teamName string) (*domain.Team, error)
 	GetByUserIDFunc       func(ctx context.Context, userID string) (*domain.Team, error)
 	ExistsFunc            func(ctx context.Context, teamName string) (bool, error)
+	UpdateSettingsFunc    func(ctx context.Context, teamName string, patch *domain.TeamSettingsPatch) (*domain.TeamSettings, error)
+	AddMembersFunc        func(ctx context.Context, teamName string, members []domain.TeamMember, audit domain.Audit) error
+	RemoveMemberFunc      func(
+		ctx context.Context,
+		teamName, userID string,
+		reassignments []domain.ReviewReassignment,
+		audit domain.Audit,
+	) error
+	MoveMemberFunc        func(ctx context.Context, userID, fromTeam, toTeam string, audit domain.Audit) error
+	ArchiveFunc           func(ctx context.Context, teamName string, archivedAt time.Time) error
+	DeleteFunc            func(ctx context.Context, teamName string) error
+	DeactivateMembersFunc func(
+		ctx context.Context,
+		teamName string,
+		userIDs []string,
+		reassignments []domain.ReviewReassignment,
+		audit domain.Audit,
+	) error
 }
 
-func (m *MockTeamRepository) CreateWithMembers(ctx context.Context, team *domain.Team) error {
+func (m *MockTeamRepository) CreateWithMembers(ctx context.Context, team *domain.Team, audit domain.Audit) error {
 	if m.CreateWithMembersFunc != nil {
-		return m.CreateWithMembersFunc(ctx, team)
+		return m.CreateWithMembersFunc(ctx, team, audit)
 	}
 	return nil
 }
@@ -48,6 +67,98 @@ func (m *MockTeamRepository) Exists(ctx context.Context, teamName string) (bool,
 	return false, nil
 }
 
+func (m *MockTeamRepository) UpdateSettings(
+	ctx context.Context,
+	teamName string,
+	patch *domain.TeamSettingsPatch,
+) (*domain.TeamSettings, error) {
+	if m.UpdateSettingsFunc != nil {
+		return m.UpdateSettingsFunc(ctx, teamName, patch)
+	}
+	return nil, nil
+}
+
+func (m *MockTeamRepository) AddMembers(
+	ctx context.Context,
+	teamName string,
+	members []domain.TeamMember,
+	audit domain.Audit,
+) error {
+	if m.AddMembersFunc != nil {
+		return m.AddMembersFunc(ctx, teamName, members, audit)
+	}
+	return nil
+}
+
+func (m *MockTeamRepository) RemoveMember(
+	ctx context.Context,
+	teamName, userID string,
+	reassignments []domain.ReviewReassignment,
+	audit domain.Audit,
+) error {
+	if m.RemoveMemberFunc != nil {
+		return m.RemoveMemberFunc(ctx, teamName, userID, reassignments, audit)
+	}
+	return nil
+}
+
+func (m *MockTeamRepository) MoveMember(ctx context.Context, userID, fromTeam, toTeam string, audit domain.Audit) error {
+	if m.MoveMemberFunc != nil {
+		return m.MoveMemberFunc(ctx, userID, fromTeam, toTeam, audit)
+	}
+	return nil
+}
+
+func (m *MockTeamRepository) AdvanceRRCursor(ctx context.Context, teamName string, count int) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockTeamRepository) Archive(ctx context.Context, teamName string, archivedAt time.Time) error {
+	if m.ArchiveFunc != nil {
+		return m.ArchiveFunc(ctx, teamName, archivedAt)
+	}
+	return nil
+}
+
+func (m *MockTeamRepository) Delete(ctx context.Context, teamName string) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, teamName)
+	}
+	return nil
+}
+
+func (m *MockTeamRepository) List(ctx context.Context, filter domain.TeamFilter) ([]domain.TeamSummary, string, error) {
+	return nil, "", nil
+}
+
+func (m *MockTeamRepository) DeactivateMembers(
+	ctx context.Context,
+	teamName string,
+	userIDs []string,
+	reassignments []domain.ReviewReassignment,
+	audit domain.Audit,
+) error {
+	if m.DeactivateMembersFunc != nil {
+		return m.DeactivateMembersFunc(ctx, teamName, userIDs, reassignments, audit)
+	}
+	return nil
+}
+
+type MockReviewPlanner struct {
+	PlanReassignmentsFunc func(ctx context.Context, team *domain.Team, userIDs []string) (*domain.ReassignmentReport, error)
+}
+
+func (m *MockReviewPlanner) PlanReassignments(
+	ctx context.Context,
+	team *domain.Team,
+	userIDs []string,
+) (*domain.ReassignmentReport, error) {
+	if m.PlanReassignmentsFunc != nil {
+		return m.PlanReassignmentsFunc(ctx, team, userIDs)
+	}
+	return &domain.ReassignmentReport{}, nil
+}
+
 func getTestLogger() *slog.Logger {
 	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
 }
@@ -69,7 +180,7 @@ func TestService_CreateTeam(t *testing.T) {
 				},
 			},
 			setupMocks: func(teamRepo *MockTeamRepository) {
-				teamRepo.CreateWithMembersFunc = func(ctx context.Context, team *domain.Team) error {
+				teamRepo.CreateWithMembersFunc = func(ctx context.Context, team *domain.Team, audit domain.Audit) error {
 					return nil
 				}
 			},
@@ -95,12 +206,28 @@ func TestService_CreateTeam(t *testing.T) {
 				},
 			},
 			setupMocks: func(teamRepo *MockTeamRepository) {
-				teamRepo.CreateWithMembersFunc = func(ctx context.Context, team *domain.Team) error {
+				teamRepo.CreateWithMembersFunc = func(ctx context.Context, team *domain.Team, audit domain.Audit) error {
 					return repository.ErrTeamAlreadyExists
 				}
 			},
 			expectedError: domain.NewError(domain.ErrCodeTeamExists, "team_name already exists"),
 		},
+		{
+			name: "user in another team",
+			team: &domain.Team{
+				Name: "team-1",
+				Members: []domain.TeamMember{
+					{ID: "user-1", Name: "User 1"},
+				},
+			},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.CreateWithMembersFunc = func(ctx context.Context, team *domain.Team, audit domain.Audit) error {
+					return repository.ErrUserInAnotherTeam
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeUserInAnotherTeam,
+				"user belongs to another team, use /team/moveMember"),
+		},
 		{
 			name: "repository error",
 			team: &domain.Team{
@@ -110,7 +237,7 @@ func TestService_CreateTeam(t *testing.T) {
 				},
 			},
 			setupMocks: func(teamRepo *MockTeamRepository) {
-				teamRepo.CreateWithMembersFunc = func(ctx context.Context, team *domain.Team) error {
+				teamRepo.CreateWithMembersFunc = func(ctx context.Context, team *domain.Team, audit domain.Audit) error {
 					return errors.New("database connection error")
 				}
 			},
@@ -123,7 +250,7 @@ func TestService_CreateTeam(t *testing.T) {
 			teamRepo := &MockTeamRepository{}
 			tt.setupMocks(teamRepo)
 
-			service := NewService(teamRepo, getTestLogger())
+			service := NewService(teamRepo, &MockReviewPlanner{}, getTestLogger())
 			err := service.CreateTeam(context.Background(), tt.team)
 			switch {
 			case tt.expectedError != nil:
@@ -198,7 +325,7 @@ func TestService_GetTeam(t *testing.T) {
 			teamRepo := &MockTeamRepository{}
 			tt.setupMocks(teamRepo)
 
-			service := NewService(teamRepo, getTestLogger())
+			service := NewService(teamRepo, &MockReviewPlanner{}, getTestLogger())
 			result, err := service.GetTeam(context.Background(), tt.teamName)
 
 			switch {
@@ -223,3 +350,628 @@ func TestService_GetTeam(t *testing.T) {
 		})
 	}
 }
+
+func TestService_UpdateSettings(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	strategyPtr := func(v domain.ReviewerStrategy) *domain.ReviewerStrategy { return &v }
+
+	// Repository applies the patch to the locked settings
+	patchSettings := func(current *domain.TeamSettings) func(
+		ctx context.Context,
+		teamName string,
+		patch *domain.TeamSettingsPatch,
+	) (*domain.TeamSettings, error) {
+		return func(ctx context.Context, teamName string, patch *domain.TeamSettingsPatch) (*domain.TeamSettings, error) {
+			settings := patch.Apply(*current)
+			if err := settings.Validate(); err != nil {
+				return nil, err
+			}
+			return settings, nil
+		}
+	}
+
+	tests := []struct {
+		name           string
+		patch          *domain.TeamSettingsPatch
+		setupMocks     func(*MockTeamRepository)
+		expectedError  *domain.Error
+		validateResult func(*testing.T, *domain.TeamSettings)
+	}{
+		{
+			name:  "successful update",
+			patch: &domain.TeamSettingsPatch{MaxReviewers: intPtr(3), MinReviewers: intPtr(3)},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.UpdateSettingsFunc = patchSettings(domain.DefaultTeamSettings())
+			},
+			validateResult: func(t *testing.T, settings *domain.TeamSettings) {
+				assert.Equal(t, 3, settings.MinReviewers)
+				assert.Equal(t, 3, settings.MaxReviewers)
+				assert.Equal(t, domain.DefaultReviewerStrategy, settings.ReviewerStrategy)
+			},
+		},
+		{
+			name:  "change strategy only",
+			patch: &domain.TeamSettingsPatch{ReviewerStrategy: strategyPtr(domain.StrategyRoundRobin)},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.UpdateSettingsFunc = patchSettings(&domain.TeamSettings{
+					ReviewerStrategy: domain.StrategyRandom,
+					MinReviewers:     1,
+					MaxReviewers:     1,
+					FallbackTeams:    []string{"team-2"},
+				})
+			},
+			validateResult: func(t *testing.T, settings *domain.TeamSettings) {
+				assert.Equal(t, domain.StrategyRoundRobin, settings.ReviewerStrategy)
+				assert.Equal(t, 1, settings.MinReviewers)
+				assert.Equal(t, 1, settings.MaxReviewers)
+				assert.Equal(t, []string{"team-2"}, settings.FallbackTeams)
+			},
+		},
+		{
+			name:  "min greater than max",
+			patch: &domain.TeamSettingsPatch{MinReviewers: intPtr(3)},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.UpdateSettingsFunc = patchSettings(domain.DefaultTeamSettings())
+			},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "min_reviewers must not exceed max_reviewers"),
+		},
+		{
+			name:  "team not found",
+			patch: &domain.TeamSettingsPatch{MaxReviewers: intPtr(1)},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.UpdateSettingsFunc = func(
+					ctx context.Context,
+					teamName string,
+					patch *domain.TeamSettingsPatch,
+				) (*domain.TeamSettings, error) {
+					return nil, repository.ErrTeamNotFound
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNotFound, "resource not found"),
+		},
+		{
+			name:  "own fallback rejected before update",
+			patch: &domain.TeamSettingsPatch{FallbackTeams: []string{"team-1"}},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.UpdateSettingsFunc = func(
+					ctx context.Context,
+					teamName string,
+					patch *domain.TeamSettingsPatch,
+				) (*domain.TeamSettings, error) {
+					return nil, errors.New("settings must not be updated")
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "team cannot be its own fallback"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamRepo := &MockTeamRepository{}
+			tt.setupMocks(teamRepo)
+
+			service := NewService(teamRepo, &MockReviewPlanner{}, getTestLogger())
+			result, err := service.UpdateSettings(context.Background(), "team-1", tt.patch)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.validateResult(t, result)
+		})
+	}
+}
+
+func TestService_DeactivateUsers(t *testing.T) {
+	active := true
+	team := &domain.Team{
+		Name: "team-1",
+		Members: []domain.TeamMember{
+			{ID: "user-1", IsActive: &active},
+			{ID: "user-2", IsActive: &active},
+			{ID: "user-3", IsActive: &active},
+		},
+	}
+	plan := &domain.ReassignmentReport{
+		Reassigned: []domain.ReviewReassignment{
+			{PRID: "pr-1", OldReviewerID: "user-1", NewReviewerID: "user-3"},
+			{PRID: "pr-1", OldReviewerID: "user-2", NewReviewerID: "user-4"},
+		},
+		Failed: []domain.FailedReassignment{
+			{PRID: "pr-2", ReviewerID: "user-1", Code: domain.ErrCodeNoCandidate},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		userIDs        []string
+		setupMocks     func(*MockTeamRepository)
+		expectedError  *domain.Error
+		validateResult func(*testing.T, *domain.DeactivationReport)
+	}{
+		{
+			name:    "successful deactivation",
+			userIDs: []string{"user-1", "user-2"},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return team, nil
+				}
+				teamRepo.DeactivateMembersFunc = func(
+					ctx context.Context,
+					teamName string,
+					userIDs []string,
+					reassignments []domain.ReviewReassignment,
+					audit domain.Audit,
+				) error {
+					assert.Equal(t, plan.Reassigned, reassignments)
+					assert.NotEmpty(t, audit.Reason)
+					return nil
+				}
+			},
+			validateResult: func(t *testing.T, report *domain.DeactivationReport) {
+				require.Len(t, report.Users, 2)
+				assert.Len(t, report.Users[0].Reassigned, 1)
+				assert.Len(t, report.Users[0].Failed, 1)
+				assert.Len(t, report.Users[1].Reassigned, 1)
+				assert.Empty(t, report.Users[1].Failed)
+
+				require.Len(t, report.PullRequests, 2)
+				assert.Equal(t, "pr-1", report.PullRequests[0].PRID)
+				assert.Len(t, report.PullRequests[0].Reassigned, 2)
+				assert.Equal(t, "pr-2", report.PullRequests[1].PRID)
+				assert.Len(t, report.PullRequests[1].Failed, 1)
+			},
+		},
+		{
+			name:    "user is not a team member",
+			userIDs: []string{"user-1", "user-9"},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return team, nil
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNotFound, "user user-9 is not a member of team team-1"),
+		},
+		{
+			name:    "duplicate user",
+			userIDs: []string{"user-1", "user-1"},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return team, nil
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "duplicate user"),
+		},
+		{
+			name:    "team not found",
+			userIDs: []string{"user-1"},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return nil, repository.ErrTeamNotFound
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNotFound, "resource not found"),
+		},
+		{
+			name:    "reviewers changed concurrently",
+			userIDs: []string{"user-1", "user-2"},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return team, nil
+				}
+				teamRepo.DeactivateMembersFunc = func(
+					ctx context.Context,
+					teamName string,
+					userIDs []string,
+					reassignments []domain.ReviewReassignment,
+					audit domain.Audit,
+				) error {
+					return repository.ErrReviewerChanged
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeConcurrentUpdate, "PR reviewers changed concurrently"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamRepo := &MockTeamRepository{}
+			tt.setupMocks(teamRepo)
+			planner := &MockReviewPlanner{
+				PlanReassignmentsFunc: func(ctx context.Context, team *domain.Team, userIDs []string) (*domain.ReassignmentReport, error) {
+					return plan, nil
+				},
+			}
+
+			service := NewService(teamRepo, planner, getTestLogger())
+			report, err := service.DeactivateUsers(context.Background(), "team-1", tt.userIDs, domain.Audit{})
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				assert.Nil(t, report)
+			} else {
+				require.NoError(t, err)
+				tt.validateResult(t, report)
+			}
+		})
+	}
+}
+
+func TestService_AddMembers(t *testing.T) {
+	active := true
+	tests := []struct {
+		name          string
+		members       []domain.TeamMember
+		setupMocks    func(*MockTeamRepository)
+		expectedError *domain.Error
+	}{
+		{
+			name:    "successful add",
+			members: []domain.TeamMember{{ID: "user-3", Name: "User 3", IsActive: &active}},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.AddMembersFunc = func(ctx context.Context, teamName string, members []domain.TeamMember, audit domain.Audit) error {
+					assert.Equal(t, 1, members[0].ReviewWeight)
+					return nil
+				}
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return &domain.Team{Name: teamName}, nil
+				}
+			},
+		},
+		{
+			name: "duplicate users",
+			members: []domain.TeamMember{
+				{ID: "user-3", Name: "User 3", IsActive: &active},
+				{ID: "user-3", Name: "User 3", IsActive: &active},
+			},
+			setupMocks:    func(teamRepo *MockTeamRepository) {},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "duplicate user"),
+		},
+		{
+			name:    "team not found",
+			members: []domain.TeamMember{{ID: "user-3", Name: "User 3", IsActive: &active}},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.AddMembersFunc = func(ctx context.Context, teamName string, members []domain.TeamMember, audit domain.Audit) error {
+					return repository.ErrTeamNotFound
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNotFound, "resource not found"),
+		},
+		{
+			name:    "user in another team",
+			members: []domain.TeamMember{{ID: "user-3", Name: "User 3", IsActive: &active}},
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.AddMembersFunc = func(ctx context.Context, teamName string, members []domain.TeamMember, audit domain.Audit) error {
+					return repository.ErrUserInAnotherTeam
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeUserInAnotherTeam,
+				"user belongs to another team, use /team/moveMember"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamRepo := &MockTeamRepository{}
+			tt.setupMocks(teamRepo)
+
+			service := NewService(teamRepo, &MockReviewPlanner{}, getTestLogger())
+			team, err := service.AddMembers(context.Background(), "team-1", tt.members)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				assert.Equal(t, tt.expectedError.Message, domainErr.Message)
+				assert.Nil(t, team)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "team-1", team.Name)
+			}
+		})
+	}
+}
+
+func TestService_RemoveMember(t *testing.T) {
+	active := true
+	team := &domain.Team{
+		Name: "team-1",
+		Members: []domain.TeamMember{
+			{ID: "user-1", IsActive: &active},
+			{ID: "user-2", IsActive: &active},
+		},
+	}
+	plan := &domain.ReassignmentReport{
+		Reassigned: []domain.ReviewReassignment{
+			{PRID: "pr-1", OldReviewerID: "user-1", NewReviewerID: "user-2"},
+		},
+		Failed: []domain.FailedReassignment{
+			{PRID: "pr-2", ReviewerID: "user-1", Code: domain.ErrCodeNoCandidate},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		userID        string
+		setupMocks    func(*MockTeamRepository)
+		expectedError *domain.Error
+	}{
+		{
+			name:   "successful removal",
+			userID: "user-1",
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.RemoveMemberFunc = func(
+					ctx context.Context,
+					teamName, userID string,
+					reassignments []domain.ReviewReassignment,
+					audit domain.Audit,
+				) error {
+					assert.Equal(t, plan.Reassigned, reassignments)
+					assert.Equal(t, reasonRemoved, audit.Reason)
+					return nil
+				}
+			},
+		},
+		{
+			name:          "user is not a team member",
+			userID:        "user-9",
+			setupMocks:    func(teamRepo *MockTeamRepository) {},
+			expectedError: domain.NewError(domain.ErrCodeNotFound, "user user-9 is not a member of team team-1"),
+		},
+		{
+			name:   "user has open PRs",
+			userID: "user-1",
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.RemoveMemberFunc = func(
+					ctx context.Context,
+					teamName, userID string,
+					reassignments []domain.ReviewReassignment,
+					audit domain.Audit,
+				) error {
+					return repository.ErrUserHasOpenPRs
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeUserHasOpenPRs,
+				"user has draft or open PRs, merge or close them first"),
+		},
+		{
+			name:   "reviewers changed concurrently",
+			userID: "user-1",
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.RemoveMemberFunc = func(
+					ctx context.Context,
+					teamName, userID string,
+					reassignments []domain.ReviewReassignment,
+					audit domain.Audit,
+				) error {
+					return repository.ErrReviewerChanged
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeConcurrentUpdate, "PR reviewers changed concurrently"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamRepo := &MockTeamRepository{
+				GetByNameFunc: func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return team, nil
+				},
+			}
+			tt.setupMocks(teamRepo)
+			planner := &MockReviewPlanner{
+				PlanReassignmentsFunc: func(ctx context.Context, team *domain.Team, userIDs []string) (*domain.ReassignmentReport, error) {
+					return plan, nil
+				},
+			}
+
+			service := NewService(teamRepo, planner, getTestLogger())
+			report, err := service.RemoveMember(context.Background(), "team-1", tt.userID, domain.Audit{})
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				assert.Equal(t, tt.expectedError.Message, domainErr.Message)
+				assert.Nil(t, report)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, report.Reassigned, 1)
+				assert.Len(t, report.Failed, 1)
+			}
+		})
+	}
+}
+
+func TestService_MoveMember(t *testing.T) {
+	tests := []struct {
+		name          string
+		fromTeam      string
+		toTeam        string
+		setupMocks    func(*MockTeamRepository)
+		expectedError *domain.Error
+	}{
+		{
+			name:     "successful move",
+			fromTeam: "team-1",
+			toTeam:   "team-2",
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return &domain.Team{Name: teamName}, nil
+				}
+			},
+		},
+		{
+			name:          "same team",
+			fromTeam:      "team-1",
+			toTeam:        "team-1",
+			setupMocks:    func(teamRepo *MockTeamRepository) {},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "from_team and to_team must differ"),
+		},
+		{
+			name:     "target team not found",
+			fromTeam: "team-1",
+			toTeam:   "team-9",
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.MoveMemberFunc = func(ctx context.Context, userID, fromTeam, toTeam string, audit domain.Audit) error {
+					return repository.ErrTeamNotFound
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNotFound, "resource not found"),
+		},
+		{
+			name:     "user is not a team member",
+			fromTeam: "team-1",
+			toTeam:   "team-2",
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.MoveMemberFunc = func(ctx context.Context, userID, fromTeam, toTeam string, audit domain.Audit) error {
+					return repository.ErrUserNotFound
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNotFound, "user user-1 is not a member of team team-1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamRepo := &MockTeamRepository{}
+			tt.setupMocks(teamRepo)
+
+			service := NewService(teamRepo, &MockReviewPlanner{}, getTestLogger())
+			team, err := service.MoveMember(context.Background(), "user-1", tt.fromTeam, tt.toTeam)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				assert.Equal(t, tt.expectedError.Message, domainErr.Message)
+				assert.Nil(t, team)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.toTeam, team.Name)
+			}
+		})
+	}
+}
+
+func TestService_DeleteTeam(t *testing.T) {
+	archivedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		mode           domain.TeamDeleteMode
+		setupMocks     func(*MockTeamRepository)
+		expectedError  *domain.Error
+		validateResult func(*testing.T, *domain.Team)
+	}{
+		{
+			name: "archive team",
+			mode: domain.TeamDeleteArchive,
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return &domain.Team{Name: teamName}, nil
+				}
+			},
+			validateResult: func(t *testing.T, team *domain.Team) {
+				require.NotNil(t, team)
+				assert.True(t, team.IsArchived())
+			},
+		},
+		{
+			name: "archive already archived team",
+			mode: domain.TeamDeleteArchive,
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.GetByNameFunc = func(ctx context.Context, teamName string) (*domain.Team, error) {
+					return &domain.Team{Name: teamName, ArchivedAt: &archivedAt}, nil
+				}
+				teamRepo.ArchiveFunc = func(ctx context.Context, teamName string, at time.Time) error {
+					t.Fatal("archived team must not be archived again")
+					return nil
+				}
+			},
+			validateResult: func(t *testing.T, team *domain.Team) {
+				require.NotNil(t, team)
+				assert.Equal(t, archivedAt, *team.ArchivedAt)
+			},
+		},
+		{
+			name:       "hard delete",
+			mode:       domain.TeamDeleteHard,
+			setupMocks: func(teamRepo *MockTeamRepository) {},
+			validateResult: func(t *testing.T, team *domain.Team) {
+				assert.Nil(t, team)
+			},
+		},
+		{
+			name: "hard delete team with members",
+			mode: domain.TeamDeleteHard,
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.DeleteFunc = func(ctx context.Context, teamName string) error {
+					return repository.ErrTeamHasMembers
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeTeamHasMembers, "team has members, remove or move them first"),
+		},
+		{
+			name: "hard delete team with open PRs",
+			mode: domain.TeamDeleteHard,
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.DeleteFunc = func(ctx context.Context, teamName string) error {
+					return repository.ErrTeamHasOpenPRs
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeTeamHasOpenPRs,
+				"team members have draft or open PRs, merge or close them first"),
+		},
+		{
+			name: "team not found",
+			mode: domain.TeamDeleteHard,
+			setupMocks: func(teamRepo *MockTeamRepository) {
+				teamRepo.DeleteFunc = func(ctx context.Context, teamName string) error {
+					return repository.ErrTeamNotFound
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNotFound, "resource not found"),
+		},
+		{
+			name:          "unknown mode",
+			mode:          "purge",
+			setupMocks:    func(teamRepo *MockTeamRepository) {},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "unknown delete mode"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teamRepo := &MockTeamRepository{}
+			tt.setupMocks(teamRepo)
+
+			service := NewService(teamRepo, &MockReviewPlanner{}, getTestLogger())
+			team, err := service.DeleteTeam(context.Background(), "team-1", tt.mode)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				assert.Equal(t, tt.expectedError.Message, domainErr.Message)
+				assert.Nil(t, team)
+			} else {
+				require.NoError(t, err)
+				tt.validateResult(t, team)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/repository"
+	"log/slog"
+)
+
+// CreateAbsence schedules a period when user is excluded from reviewer candidates
+func (s *Service) CreateAbsence(ctx context.Context, absence *domain.Absence) error {
+	if err := validateAbsence(absence); err != nil {
+		return err
+	}
+
+	err := s.userRepo.CreateAbsence(ctx, absence)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			s.log.Warn("user not found", slog.String("user_id", absence.UserID))
+			return domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		s.log.Error("failed to create absence", slog.String("error", err.Error()))
+		return fmt.Errorf("failed to create absence: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) GetAbsences(ctx context.Context, userID string) ([]domain.Absence, error) {
+	// Check user existence
+	_, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			s.log.Warn("user not found", slog.String("user_id", userID))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		s.log.Error("failed to get user", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	absences, err := s.userRepo.GetAbsences(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to get absences", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to get absences: %w", err)
+	}
+	return absences, nil
+}
+
+func (s *Service) UpdateAbsence(ctx context.Context, absence *domain.Absence) error {
+	if err := validateAbsence(absence); err != nil {
+		return err
+	}
+
+	err := s.userRepo.UpdateAbsence(ctx, absence)
+	if err != nil {
+		if errors.Is(err, repository.ErrAbsenceNotFound) {
+			s.log.Warn("absence not found", slog.Int64("absence_id", absence.ID))
+			return domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		s.log.Error("failed to update absence", slog.String("error", err.Error()))
+		return fmt.Errorf("failed to update absence: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) DeleteAbsence(ctx context.Context, absenceID int64) error {
+	err := s.userRepo.DeleteAbsence(ctx, absenceID)
+	if err != nil {
+		if errors.Is(err, repository.ErrAbsenceNotFound) {
+			s.log.Warn("absence not found", slog.Int64("absence_id", absenceID))
+			return domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		s.log.Error("failed to delete absence", slog.String("error", err.Error()))
+		return fmt.Errorf("failed to delete absence: %w", err)
+	}
+	return nil
+}
+
+func validateAbsence(absence *domain.Absence) error {
+	if !absence.StartsAt.Before(absence.EndsAt) {
+		return domain.NewError(domain.ErrCodeBadRequest, "starts_at must be before ends_at")
+	}
+	return nil
+}
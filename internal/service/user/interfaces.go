@@ -6,6 +6,17 @@ import (
 )
 
 type ServiceInterface interface {
-	SetUserIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error)
+	SetUserIsActive(
+		ctx context.Context,
+		userID string,
+		isActive bool,
+		reassignReviews bool,
+	) (*domain.User, *domain.ReassignmentReport, error)
+	GetUser(ctx context.Context, userID string) (*domain.UserProfile, error)
 	GetPRsByUserID(ctx context.Context, userID string) ([]domain.PullRequestShort, error)
+	ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, string, error)
+	CreateAbsence(ctx context.Context, absence *domain.Absence) error
+	GetAbsences(ctx context.Context, userID string) ([]domain.Absence, error)
+	UpdateAbsence(ctx context.Context, absence *domain.Absence) error
+	DeleteAbsence(ctx context.Context, absenceID int64) error
 }
@@ -5,43 +5,145 @@ import (
 	"errors"
 	"fmt"
 	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/metrics"
 	"github.com/platonso/avito-pr-service/internal/repository"
 	"log/slog"
+	"time"
 )
 
+// Reason recorded for reviews moved away from a deactivated user
+const reasonDeactivated = "reviewer deactivated"
+
+// ReviewPlanner plans redistribution of open reviews of a leaving user following the regular reassignment rules
+type ReviewPlanner interface {
+	PlanUserReassignments(ctx context.Context, userID string) (*domain.ReassignmentReport, error)
+}
+
 type Service struct {
 	userRepo repository.UserRepository
+	planner  ReviewPlanner
 	log      *slog.Logger
 }
 
 func NewService(
 	userRepo repository.UserRepository,
+	planner ReviewPlanner,
 	log *slog.Logger,
 ) *Service {
 	return &Service{
 		userRepo: userRepo,
+		planner:  planner,
 		log:      log,
 	}
 }
 
-func (s *Service) SetUserIsActive(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
-	err := s.userRepo.SetIsActive(ctx, userID, isActive)
+// SetUserIsActive updates user activity flag. On request open reviews of deactivated user are moved to other
+// reviewers in the same transaction, reviews without a replacement stay assigned
+func (s *Service) SetUserIsActive(
+	ctx context.Context,
+	userID string,
+	isActive bool,
+	reassignReviews bool,
+) (*domain.User, *domain.ReassignmentReport, error) {
+	audit := domain.Audit{ActorID: domain.ActorID(ctx, "")}
+	var report *domain.ReassignmentReport
+	var err error
+	if !isActive && reassignReviews {
+		audit.Reason = reasonDeactivated
+		report, err = s.deactivateWithReassignments(ctx, userID, audit)
+	} else {
+		err = s.userRepo.SetIsActive(ctx, userID, isActive, audit)
+	}
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
 			s.log.Warn("user not found", slog.String("user_id", userID))
-			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+			return nil, nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		var domainErr *domain.Error
+		if errors.As(err, &domainErr) {
+			return nil, nil, err
 		}
 		s.log.Error("failed to update user status", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to update user status: %w", err)
+		return nil, nil, fmt.Errorf("failed to update user status: %w", err)
 	}
+	s.log.Info("user status changed",
+		slog.String("user_id", userID),
+		slog.Bool("is_active", isActive),
+		slog.String("actor_id", audit.ActorID))
 
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		s.log.Error("failed to get updated user", slog.String("error", err.Error()))
-		return nil, fmt.Errorf("failed to get updated user: %w", err)
+		return nil, nil, fmt.Errorf("failed to get updated user: %w", err)
+	}
+
+	return user, report, nil
+}
+
+// Plan replacements for open reviews of user, then deactivate user and apply the plan at once
+func (s *Service) deactivateWithReassignments(
+	ctx context.Context,
+	userID string,
+	audit domain.Audit,
+) (*domain.ReassignmentReport, error) {
+	plan, err := s.planner.PlanUserReassignments(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.userRepo.Deactivate(ctx, userID, plan.Reassigned, audit)
+	if err != nil {
+		if errors.Is(err, repository.ErrReviewerChanged) {
+			s.log.Warn("PR reviewers changed concurrently", slog.String("user_id", userID))
+			return nil, domain.NewError(domain.ErrCodeConcurrentUpdate, "PR reviewers changed concurrently")
+		}
+		return nil, err
 	}
+	metrics.Reassignments.Add(float64(len(plan.Reassigned)))
 
-	return user, nil
+	return plan, nil
+}
+
+// GetUser returns user profile with current absence and review workload
+func (s *Service) GetUser(ctx context.Context, userID string) (*domain.UserProfile, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			s.log.Warn("user not found", slog.String("user_id", userID))
+			return nil, domain.NewError(domain.ErrCodeNotFound, "resource not found")
+		}
+		s.log.Error("failed to get user", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	absences, err := s.userRepo.GetAbsences(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to get absences", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to get absences: %w", err)
+	}
+
+	workload, err := s.userRepo.GetWorkload(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to get user workload", slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to get user workload: %w", err)
+	}
+
+	profile := &domain.UserProfile{
+		User:     *user,
+		Workload: *workload,
+	}
+
+	// Absence is active during [starts_at, ends_at)
+	now := time.Now()
+	for i := range absences {
+		if !absences[i].StartsAt.After(now) && absences[i].EndsAt.After(now) {
+			profile.IsAbsent = true
+			profile.CurrentAbsence = &absences[i]
+			break
+		}
+	}
+
+	return profile, nil
 }
 
 func (s *Service) GetPRsByUserID(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
@@ -63,3 +165,22 @@ func (s *Service) GetPRsByUserID(ctx context.Context, userID string) ([]domain.P
 	}
 	return prs, nil
 }
+
+// ListUsers returns a page of users matching filter ordered by user_id or username
+func (s *Service) ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, string, error) {
+	err := filter.Normalize(domain.UserSortID, domain.SortAsc, domain.UserSortID, domain.UserSortName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	users, next, err := s.userRepo.List(ctx, filter)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, "", domain.NewError(domain.ErrCodeBadRequest, "invalid cursor")
+		}
+		s.log.Error("failed to list users", slog.String("error", err.Error()))
+		return nil, "", fmt.Errorf("failed to list users: %w", err)
+	}
+
+	return users, next, nil
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/platonso/avito-pr-service/internal/domain"
 	"github.com/platonso/avito-pr-service/internal/repository"
@@ -14,14 +15,32 @@ import (
 )
 
 type MockUserRepository struct {
-	SetIsActiveFunc    func(ctx context.Context, userID string, isActive bool) error
+	SetIsActiveFunc    func(ctx context.Context, userID string, isActive bool, audit domain.Audit) error
+	DeactivateFunc     func(ctx context.Context, userID string, reassignments []domain.ReviewReassignment, audit domain.Audit) error
 	GetByIDFunc        func(ctx context.Context, userID string) (*domain.User, error)
 	GetPRsByUserIDFunc func(ctx context.Context, userID string) ([]domain.PullRequestShort, error)
+	CreateAbsenceFunc  func(ctx context.Context, absence *domain.Absence) error
+	GetAbsencesFunc    func(ctx context.Context, userID string) ([]domain.Absence, error)
+	UpdateAbsenceFunc  func(ctx context.Context, absence *domain.Absence) error
+	DeleteAbsenceFunc  func(ctx context.Context, absenceID int64) error
+	GetWorkloadFunc    func(ctx context.Context, userID string) (*domain.UserWorkload, error)
 }
 
-func (m *MockUserRepository) SetIsActive(ctx context.Context, userID string, isActive bool) error {
+func (m *MockUserRepository) SetIsActive(ctx context.Context, userID string, isActive bool, audit domain.Audit) error {
 	if m.SetIsActiveFunc != nil {
-		return m.SetIsActiveFunc(ctx, userID, isActive)
+		return m.SetIsActiveFunc(ctx, userID, isActive, audit)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) Deactivate(
+	ctx context.Context,
+	userID string,
+	reassignments []domain.ReviewReassignment,
+	audit domain.Audit,
+) error {
+	if m.DeactivateFunc != nil {
+		return m.DeactivateFunc(ctx, userID, reassignments, audit)
 	}
 	return nil
 }
@@ -40,6 +59,56 @@ func (m *MockUserRepository) GetPRsByUserID(ctx context.Context, userID string)
 	return nil, nil
 }
 
+func (m *MockUserRepository) CreateAbsence(ctx context.Context, absence *domain.Absence) error {
+	if m.CreateAbsenceFunc != nil {
+		return m.CreateAbsenceFunc(ctx, absence)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) GetAbsences(ctx context.Context, userID string) ([]domain.Absence, error) {
+	if m.GetAbsencesFunc != nil {
+		return m.GetAbsencesFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockUserRepository) UpdateAbsence(ctx context.Context, absence *domain.Absence) error {
+	if m.UpdateAbsenceFunc != nil {
+		return m.UpdateAbsenceFunc(ctx, absence)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) DeleteAbsence(ctx context.Context, absenceID int64) error {
+	if m.DeleteAbsenceFunc != nil {
+		return m.DeleteAbsenceFunc(ctx, absenceID)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) GetWorkload(ctx context.Context, userID string) (*domain.UserWorkload, error) {
+	if m.GetWorkloadFunc != nil {
+		return m.GetWorkloadFunc(ctx, userID)
+	}
+	return &domain.UserWorkload{}, nil
+}
+
+func (m *MockUserRepository) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, string, error) {
+	return nil, "", nil
+}
+
+type MockReviewPlanner struct {
+	PlanUserReassignmentsFunc func(ctx context.Context, userID string) (*domain.ReassignmentReport, error)
+}
+
+func (m *MockReviewPlanner) PlanUserReassignments(ctx context.Context, userID string) (*domain.ReassignmentReport, error) {
+	if m.PlanUserReassignmentsFunc != nil {
+		return m.PlanUserReassignmentsFunc(ctx, userID)
+	}
+	return &domain.ReassignmentReport{}, nil
+}
+
 func getTestLogger() *slog.Logger {
 	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelError}))
 }
@@ -59,7 +128,7 @@ func TestService_SetUserIsActive(t *testing.T) {
 			isActive: true,
 			setupMocks: func(userRepo *MockUserRepository) {
 				active := true
-				userRepo.SetIsActiveFunc = func(ctx context.Context, userID string, isActive bool) error {
+				userRepo.SetIsActiveFunc = func(ctx context.Context, userID string, isActive bool, audit domain.Audit) error {
 					return nil
 				}
 				userRepo.GetByIDFunc = func(ctx context.Context, userID string) (*domain.User, error) {
@@ -84,7 +153,7 @@ func TestService_SetUserIsActive(t *testing.T) {
 			isActive: false,
 			setupMocks: func(userRepo *MockUserRepository) {
 				active := false
-				userRepo.SetIsActiveFunc = func(ctx context.Context, userID string, isActive bool) error {
+				userRepo.SetIsActiveFunc = func(ctx context.Context, userID string, isActive bool, audit domain.Audit) error {
 					return nil
 				}
 				userRepo.GetByIDFunc = func(ctx context.Context, userID string) (*domain.User, error) {
@@ -107,7 +176,7 @@ func TestService_SetUserIsActive(t *testing.T) {
 			userID:   "user-1",
 			isActive: true,
 			setupMocks: func(userRepo *MockUserRepository) {
-				userRepo.SetIsActiveFunc = func(ctx context.Context, userID string, isActive bool) error {
+				userRepo.SetIsActiveFunc = func(ctx context.Context, userID string, isActive bool, audit domain.Audit) error {
 					return repository.ErrUserNotFound
 				}
 			},
@@ -118,7 +187,7 @@ func TestService_SetUserIsActive(t *testing.T) {
 			userID:   "user-1",
 			isActive: true,
 			setupMocks: func(userRepo *MockUserRepository) {
-				userRepo.SetIsActiveFunc = func(ctx context.Context, userID string, isActive bool) error {
+				userRepo.SetIsActiveFunc = func(ctx context.Context, userID string, isActive bool, audit domain.Audit) error {
 					return errors.New("database connection error")
 				}
 			},
@@ -128,7 +197,7 @@ func TestService_SetUserIsActive(t *testing.T) {
 			userID:   "user-1",
 			isActive: true,
 			setupMocks: func(userRepo *MockUserRepository) {
-				userRepo.SetIsActiveFunc = func(ctx context.Context, userID string, isActive bool) error {
+				userRepo.SetIsActiveFunc = func(ctx context.Context, userID string, isActive bool, audit domain.Audit) error {
 					return nil
 				}
 				userRepo.GetByIDFunc = func(ctx context.Context, userID string) (*domain.User, error) {
@@ -144,8 +213,8 @@ func TestService_SetUserIsActive(t *testing.T) {
 			userRepo := &MockUserRepository{}
 			tt.setupMocks(userRepo)
 
-			service := NewService(userRepo, getTestLogger())
-			result, err := service.SetUserIsActive(context.Background(), tt.userID, tt.isActive)
+			service := NewService(userRepo, &MockReviewPlanner{}, getTestLogger())
+			result, _, err := service.SetUserIsActive(context.Background(), tt.userID, tt.isActive, false)
 
 			switch {
 			case tt.expectedError != nil:
@@ -169,6 +238,116 @@ func TestService_SetUserIsActive(t *testing.T) {
 	}
 }
 
+func TestService_SetUserIsActive_ReassignReviews(t *testing.T) {
+	active := false
+	plan := &domain.ReassignmentReport{
+		Reassigned: []domain.ReviewReassignment{
+			{PRID: "pr-1", OldReviewerID: "user-1", NewReviewerID: "user-2"},
+		},
+		Failed: []domain.FailedReassignment{
+			{PRID: "pr-3", ReviewerID: "user-1", Code: domain.ErrCodeNoCandidate},
+		},
+	}
+
+	var deactivated []domain.ReviewReassignment
+	var setIsActiveCalled bool
+	userRepo := &MockUserRepository{
+		SetIsActiveFunc: func(ctx context.Context, userID string, isActive bool, audit domain.Audit) error {
+			setIsActiveCalled = true
+			return nil
+		},
+		DeactivateFunc: func(
+			ctx context.Context,
+			userID string,
+			reassignments []domain.ReviewReassignment,
+			audit domain.Audit,
+		) error {
+			assert.Equal(t, "user-1", userID)
+			assert.NotEmpty(t, audit.Reason)
+			deactivated = reassignments
+			return nil
+		},
+		GetByIDFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			return &domain.User{ID: userID, TeamName: "team-1", IsActive: &active}, nil
+		},
+	}
+	planner := &MockReviewPlanner{
+		PlanUserReassignmentsFunc: func(ctx context.Context, userID string) (*domain.ReassignmentReport, error) {
+			return plan, nil
+		},
+	}
+
+	service := NewService(userRepo, planner, getTestLogger())
+
+	t.Run("reviews are reassigned with deactivation", func(t *testing.T) {
+		deactivated, setIsActiveCalled = nil, false
+		user, report, err := service.SetUserIsActive(context.Background(), "user-1", false, true)
+		require.NoError(t, err)
+		assert.NotNil(t, user)
+		assert.Equal(t, plan, report)
+		assert.Equal(t, plan.Reassigned, deactivated)
+		assert.False(t, setIsActiveCalled)
+	})
+
+	t.Run("reviews are kept without flag", func(t *testing.T) {
+		deactivated, setIsActiveCalled = nil, false
+		_, report, err := service.SetUserIsActive(context.Background(), "user-1", false, false)
+		require.NoError(t, err)
+		assert.Nil(t, report)
+		assert.Nil(t, deactivated)
+		assert.True(t, setIsActiveCalled)
+	})
+
+	t.Run("reviewers changed concurrently", func(t *testing.T) {
+		failingRepo := *userRepo
+		failingRepo.DeactivateFunc = func(
+			ctx context.Context,
+			userID string,
+			reassignments []domain.ReviewReassignment,
+			audit domain.Audit,
+		) error {
+			return repository.ErrReviewerChanged
+		}
+		_, _, err := NewService(&failingRepo, planner, getTestLogger()).
+			SetUserIsActive(context.Background(), "user-1", false, true)
+		var domainErr *domain.Error
+		require.True(t, errors.As(err, &domainErr))
+		assert.Equal(t, domain.ErrCodeConcurrentUpdate, domainErr.Code)
+	})
+
+	t.Run("planning error aborts deactivation", func(t *testing.T) {
+		failing := &MockReviewPlanner{
+			PlanUserReassignmentsFunc: func(ctx context.Context, userID string) (*domain.ReassignmentReport, error) {
+				return nil, errors.New("database connection error")
+			},
+		}
+		deactivated = nil
+		_, _, err := NewService(userRepo, failing, getTestLogger()).
+			SetUserIsActive(context.Background(), "user-1", false, true)
+		require.Error(t, err)
+		assert.Nil(t, deactivated)
+	})
+}
+
+func TestService_SetUserIsActive_RecordsActor(t *testing.T) {
+	active := true
+	var recorded domain.Audit
+	userRepo := &MockUserRepository{
+		SetIsActiveFunc: func(ctx context.Context, userID string, isActive bool, audit domain.Audit) error {
+			recorded = audit
+			return nil
+		},
+		GetByIDFunc: func(ctx context.Context, userID string) (*domain.User, error) {
+			return &domain.User{ID: userID, IsActive: &active}, nil
+		},
+	}
+	ctx := domain.WithCaller(context.Background(), &domain.Caller{UserID: "admin-1", Role: domain.RoleAdmin})
+
+	_, _, err := NewService(userRepo, &MockReviewPlanner{}, getTestLogger()).SetUserIsActive(ctx, "user-1", true, false)
+	require.NoError(t, err)
+	assert.Equal(t, "admin-1", recorded.ActorID)
+}
+
 func TestService_GetPRsByUserID(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -279,7 +458,7 @@ func TestService_GetPRsByUserID(t *testing.T) {
 			userRepo := &MockUserRepository{}
 			tt.setupMocks(userRepo)
 
-			service := NewService(userRepo, getTestLogger())
+			service := NewService(userRepo, &MockReviewPlanner{}, getTestLogger())
 			result, err := service.GetPRsByUserID(context.Background(), tt.userID)
 
 			switch {
@@ -303,3 +482,169 @@ func TestService_GetPRsByUserID(t *testing.T) {
 		})
 	}
 }
+
+func TestService_CreateAbsence(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name          string
+		absence       *domain.Absence
+		setupMocks    func(*MockUserRepository)
+		expectedError *domain.Error
+	}{
+		{
+			name:    "successful creation",
+			absence: &domain.Absence{UserID: "user-1", StartsAt: now, EndsAt: now.Add(24 * time.Hour)},
+			setupMocks: func(userRepo *MockUserRepository) {
+				userRepo.CreateAbsenceFunc = func(ctx context.Context, absence *domain.Absence) error {
+					absence.ID = 1
+					return nil
+				}
+			},
+		},
+		{
+			name:          "empty range",
+			absence:       &domain.Absence{UserID: "user-1", StartsAt: now, EndsAt: now},
+			setupMocks:    func(userRepo *MockUserRepository) {},
+			expectedError: domain.NewError(domain.ErrCodeBadRequest, "starts_at must be before ends_at"),
+		},
+		{
+			name:    "user not found",
+			absence: &domain.Absence{UserID: "user-1", StartsAt: now, EndsAt: now.Add(time.Hour)},
+			setupMocks: func(userRepo *MockUserRepository) {
+				userRepo.CreateAbsenceFunc = func(ctx context.Context, absence *domain.Absence) error {
+					return repository.ErrUserNotFound
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNotFound, "resource not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := &MockUserRepository{}
+			tt.setupMocks(userRepo)
+
+			service := NewService(userRepo, &MockReviewPlanner{}, getTestLogger())
+			err := service.CreateAbsence(context.Background(), tt.absence)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+			} else {
+				require.NoError(t, err)
+				assert.NotZero(t, tt.absence.ID)
+			}
+		})
+	}
+}
+
+func TestService_UpdateAndDeleteAbsence(t *testing.T) {
+	now := time.Now()
+	userRepo := &MockUserRepository{
+		UpdateAbsenceFunc: func(ctx context.Context, absence *domain.Absence) error {
+			return repository.ErrAbsenceNotFound
+		},
+		DeleteAbsenceFunc: func(ctx context.Context, absenceID int64) error {
+			if absenceID == 1 {
+				return nil
+			}
+			return repository.ErrAbsenceNotFound
+		},
+	}
+	service := NewService(userRepo, &MockReviewPlanner{}, getTestLogger())
+
+	var domainErr *domain.Error
+	err := service.UpdateAbsence(context.Background(), &domain.Absence{ID: 2, StartsAt: now, EndsAt: now.Add(time.Hour)})
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domain.ErrCodeNotFound, domainErr.Code)
+
+	require.NoError(t, service.DeleteAbsence(context.Background(), 1))
+	err = service.DeleteAbsence(context.Background(), 2)
+	require.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, domain.ErrCodeNotFound, domainErr.Code)
+}
+
+func TestService_GetUser(t *testing.T) {
+	active := true
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*MockUserRepository)
+		expectedError  *domain.Error
+		validateResult func(*testing.T, *domain.UserProfile)
+	}{
+		{
+			name: "absent user with workload",
+			setupMocks: func(userRepo *MockUserRepository) {
+				userRepo.GetByIDFunc = func(ctx context.Context, userID string) (*domain.User, error) {
+					return &domain.User{ID: userID, Name: "User 1", TeamName: "team-1", IsActive: &active}, nil
+				}
+				userRepo.GetAbsencesFunc = func(ctx context.Context, userID string) ([]domain.Absence, error) {
+					return []domain.Absence{
+						{ID: 1, StartsAt: now.Add(-48 * time.Hour), EndsAt: now.Add(-24 * time.Hour)},
+						{ID: 2, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+					}, nil
+				}
+				userRepo.GetWorkloadFunc = func(ctx context.Context, userID string) (*domain.UserWorkload, error) {
+					return &domain.UserWorkload{OpenReviews: 3, AuthoredOpenPRs: 1, MergedPRs: 5}, nil
+				}
+			},
+			validateResult: func(t *testing.T, profile *domain.UserProfile) {
+				assert.Equal(t, "team-1", profile.TeamName)
+				assert.True(t, profile.IsAbsent)
+				require.NotNil(t, profile.CurrentAbsence)
+				assert.Equal(t, int64(2), profile.CurrentAbsence.ID)
+				assert.Equal(t, 3, profile.Workload.OpenReviews)
+				assert.Equal(t, 5, profile.Workload.MergedPRs)
+			},
+		},
+		{
+			name: "available user",
+			setupMocks: func(userRepo *MockUserRepository) {
+				userRepo.GetByIDFunc = func(ctx context.Context, userID string) (*domain.User, error) {
+					return &domain.User{ID: userID, IsActive: &active}, nil
+				}
+				userRepo.GetAbsencesFunc = func(ctx context.Context, userID string) ([]domain.Absence, error) {
+					return []domain.Absence{{ID: 1, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}}, nil
+				}
+			},
+			validateResult: func(t *testing.T, profile *domain.UserProfile) {
+				assert.False(t, profile.IsAbsent)
+				assert.Nil(t, profile.CurrentAbsence)
+			},
+		},
+		{
+			name: "user not found",
+			setupMocks: func(userRepo *MockUserRepository) {
+				userRepo.GetByIDFunc = func(ctx context.Context, userID string) (*domain.User, error) {
+					return nil, repository.ErrUserNotFound
+				}
+			},
+			expectedError: domain.NewError(domain.ErrCodeNotFound, "resource not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := &MockUserRepository{}
+			tt.setupMocks(userRepo)
+
+			service := NewService(userRepo, &MockReviewPlanner{}, getTestLogger())
+			profile, err := service.GetUser(context.Background(), "user-1")
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				var domainErr *domain.Error
+				require.True(t, errors.As(err, &domainErr))
+				assert.Equal(t, tt.expectedError.Code, domainErr.Code)
+				assert.Nil(t, profile)
+			} else {
+				require.NoError(t, err)
+				tt.validateResult(t, profile)
+			}
+		})
+	}
+}
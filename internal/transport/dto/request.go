@@ -2,30 +2,178 @@ package dto
 
 import (
 	"github.com/platonso/avito-pr-service/internal/domain"
+	"time"
 )
 
+// PageReq holds keyset pagination query parameters
+type PageReq struct {
+	Limit  int    `form:"limit"`
+	Cursor string `form:"cursor"`
+	SortBy string `form:"sort"`
+	Order  string `form:"order"`
+}
+
+func (r PageReq) ToDomain() domain.PageRequest {
+	return domain.PageRequest{
+		Limit:  r.Limit,
+		Cursor: r.Cursor,
+		SortBy: r.SortBy,
+		Order:  domain.SortOrder(r.Order),
+	}
+}
+
+// Team request DTO
+type ListTeamsReq struct {
+	Name     string `form:"name"`
+	Archived *bool  `form:"archived"`
+	PageReq
+}
+
+type UpdateTeamSettingsReq struct {
+	TeamName string `json:"team_name" binding:"required"`
+	domain.TeamSettingsPatch
+}
+
+type DeactivateUsersReq struct {
+	TeamName string   `json:"team_name" binding:"required"`
+	UserIDs  []string `json:"user_ids" binding:"required,min=1"`
+	ActorID  string   `json:"actor_id"`
+	Reason   string   `json:"reason"`
+}
+
+type AddMembersReq struct {
+	TeamName string              `json:"team_name" binding:"required"`
+	Members  []domain.TeamMember `json:"members" binding:"required,min=1,dive"`
+}
+
+type RemoveMemberReq struct {
+	TeamName string `json:"team_name" binding:"required"`
+	UserID   string `json:"user_id" binding:"required"`
+	ActorID  string `json:"actor_id"`
+	Reason   string `json:"reason"`
+}
+
+type MoveMemberReq struct {
+	UserID   string `json:"user_id" binding:"required"`
+	FromTeam string `json:"from_team" binding:"required"`
+	ToTeam   string `json:"to_team" binding:"required"`
+}
+
 // User request DTO
+type ListUsersReq struct {
+	TeamName string `form:"team_name"`
+	IsActive *bool  `form:"is_active"`
+	Search   string `form:"q"`
+	PageReq
+}
+
 type SetIsActiveReq struct {
 	UserID   string `json:"user_id" binding:"required"`
 	IsActive *bool  `json:"is_active" binding:"required"`
+
+	ReassignReviews bool `json:"reassign_reviews"`
+}
+
+type CreateAbsenceReq struct {
+	UserID   string    `json:"user_id" binding:"required"`
+	StartsAt time.Time `json:"starts_at" binding:"required"`
+	EndsAt   time.Time `json:"ends_at" binding:"required"`
+	Reason   string    `json:"reason"`
 }
+
+type UpdateAbsenceReq struct {
+	AbsenceID int64     `json:"absence_id" binding:"required"`
+	StartsAt  time.Time `json:"starts_at" binding:"required"`
+	EndsAt    time.Time `json:"ends_at" binding:"required"`
+	Reason    string    `json:"reason"`
+}
+
+type DeleteAbsenceReq struct {
+	AbsenceID int64 `json:"absence_id" binding:"required"`
+}
+
 type GetUserReviewsResp struct {
 	UserID       string                    `json:"user_id"`
 	PullRequests []domain.PullRequestShort `json:"pull_requests"`
 }
 
 // Pull request (request DTO)
+type ListPRsReq struct {
+	Status      string     `form:"status"`
+	AuthorID    string     `form:"author_id"`
+	ReviewerID  string     `form:"reviewer_id"`
+	TeamName    string     `form:"team_name"`
+	CreatedFrom *time.Time `form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo   *time.Time `form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+	PageReq
+}
+
 type CreatePRReq struct {
-	PRID     string `json:"pull_request_id" binding:"required"`
-	PRName   string `json:"pull_request_name" binding:"required"`
-	AuthorID string `json:"author_id" binding:"required"`
+	PRID              string   `json:"pull_request_id" binding:"required"`
+	PRName            string   `json:"pull_request_name" binding:"required"`
+	AuthorID          string   `json:"author_id" binding:"required"`
+	Draft             bool     `json:"draft"`
+	ReviewersCount    *int     `json:"reviewers_count" binding:"omitempty,min=0"`
+	RequiredReviewers []string `json:"required_reviewers" binding:"omitempty,dive,required"`
+	ExcludedReviewers []string `json:"excluded_reviewers" binding:"omitempty,dive,required"`
+	RequiredApprovals *int     `json:"required_approvals" binding:"omitempty,min=0"`
 }
 
-type MergePRReq struct {
+type ReadyPRReq struct {
+	PRID              string   `json:"pull_request_id" binding:"required"`
+	ReviewersCount    *int     `json:"reviewers_count" binding:"omitempty,min=0"`
+	RequiredReviewers []string `json:"required_reviewers" binding:"omitempty,dive,required"`
+	ExcludedReviewers []string `json:"excluded_reviewers" binding:"omitempty,dive,required"`
+}
+
+type PRIDReq struct {
 	PRID string `json:"pull_request_id" binding:"required"`
 }
 
+type MergePRReq struct {
+	PRID   string `json:"pull_request_id" binding:"required"`
+	Force  bool   `json:"force"`
+	Reason string `json:"reason"`
+}
+
+type ReviewPRReq struct {
+	PRID       string             `json:"pull_request_id" binding:"required"`
+	ReviewerID string             `json:"reviewer_id" binding:"required"`
+	State      domain.ReviewState `json:"state" binding:"required,oneof=APPROVED CHANGES_REQUESTED COMMENTED"`
+	Comment    string             `json:"comment"`
+}
+
 type ReassignPRReq struct {
 	PRID          string `json:"pull_request_id" binding:"required"`
 	OldReviewerID string `json:"old_reviewer_id" binding:"required"`
+	ActorID       string `json:"actor_id"`
+	Reason        string `json:"reason"`
+}
+
+// Statistics request DTO
+type StatsReq struct {
+	From      *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To        *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	TeamName  string     `form:"team_name"`
+	DateField string     `form:"date_field"`
+	GroupBy   string     `form:"group_by"`
+}
+
+func (r StatsReq) ToDomain() domain.StatsFilter {
+	return domain.StatsFilter{
+		From:      r.From,
+		To:        r.To,
+		TeamName:  r.TeamName,
+		DateField: domain.StatsDateField(r.DateField),
+		GroupBy:   domain.StatsGroupBy(r.GroupBy),
+	}
+}
+
+type CreateAPIKeyReq struct {
+	Name string      `json:"name" binding:"required"`
+	Role domain.Role `json:"role" binding:"required,oneof=admin service read_only"`
+}
+
+type RevokeAPIKeyReq struct {
+	KeyID string `json:"key_id" binding:"required"`
 }
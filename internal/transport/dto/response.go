@@ -6,11 +6,58 @@ import (
 	"github.com/platonso/avito-pr-service/internal/domain"
 	"log/slog"
 	"net/http"
+	"time"
 )
 
+// Team response DTO
+type TeamSettingsResp struct {
+	TeamName string               `json:"team_name"`
+	Settings *domain.TeamSettings `json:"settings"`
+}
+
+type DeactivateUsersResp struct {
+	Report *domain.DeactivationReport `json:"report"`
+}
+
+type DeleteTeamResp struct {
+	TeamName   string                `json:"team_name"`
+	Mode       domain.TeamDeleteMode `json:"mode"`
+	ArchivedAt *time.Time            `json:"archived_at,omitempty"`
+}
+
+type ListTeamsResp struct {
+	Teams      []domain.TeamSummary `json:"teams"`
+	NextCursor string               `json:"next_cursor,omitempty"`
+}
+
+type RemoveMemberResp struct {
+	TeamName     string                     `json:"team_name"`
+	UserID       string                     `json:"user_id"`
+	Reassignment *domain.ReassignmentReport `json:"reassignment"`
+}
+
 // User response DTO
 type SetIsActiveResp struct {
-	User *domain.User `json:"user"`
+	User         *domain.User               `json:"user"`
+	Reassignment *domain.ReassignmentReport `json:"reassignment,omitempty"`
+}
+
+type UserProfileResp struct {
+	User *domain.UserProfile `json:"user"`
+}
+
+type ListUsersResp struct {
+	Users      []domain.User `json:"users"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+type AbsenceResp struct {
+	Absence *domain.Absence `json:"absence"`
+}
+
+type AbsencesResp struct {
+	UserID   string           `json:"user_id"`
+	Absences []domain.Absence `json:"absences"`
 }
 
 // Pull request response DTO
@@ -23,6 +70,21 @@ type ReassignPRResp struct {
 	ReplacedBy string              `json:"replaced_by"`
 }
 
+type PRBatchResp struct {
+	PullRequests []domain.PullRequest `json:"pull_requests"`
+	NotFound     []string             `json:"not_found"`
+}
+
+type ListPRsResp struct {
+	PullRequests []domain.PullRequestShort `json:"pull_requests"`
+	NextCursor   string                    `json:"next_cursor,omitempty"`
+}
+
+type PRHistoryResp struct {
+	PRID   string                 `json:"pull_request_id"`
+	Events []domain.ReviewerEvent `json:"events"`
+}
+
 // Statistics response DTO
 type ReviewerStatsResp struct {
 	Stats []domain.ReviewerStat `json:"stats"`
@@ -32,12 +94,43 @@ type PRStatsResp struct {
 	Stats []domain.PullRequestStat `json:"stats"`
 }
 
+type LatencyStatsResp struct {
+	Stats *domain.LatencyReport `json:"stats"`
+}
+
+type FairnessResp struct {
+	Teams []domain.TeamFairness `json:"teams"`
+}
+
+// CreateAPIKeyResp carries the plain key, it cannot be retrieved later
+type CreateAPIKeyResp struct {
+	APIKey *domain.APIKey `json:"api_key"`
+	Key    string         `json:"key"`
+}
+
+type ListAPIKeysResp struct {
+	APIKeys []domain.APIKey `json:"api_keys"`
+}
+
+const (
+	HealthOK       = "ok"
+	HealthNotReady = "not_ready"
+)
+
+type HealthResp struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
 // Error response DTO
 type ErrorResponse struct {
-	Error struct {
-		Code    string `json:"code"`
-		Message string `json:"message"`
-	} `json:"error"`
+	Error ErrorBody `json:"error"`
+}
+
+type ErrorBody struct {
+	Code    string   `json:"code"`
+	Message string   `json:"message"`
+	Details []string `json:"details,omitempty"`
 }
 
 func WriteJSONError(c *gin.Context, logger *slog.Logger, err error) {
@@ -48,21 +141,34 @@ func WriteJSONError(c *gin.Context, logger *slog.Logger, err error) {
 		switch domainErr.Code {
 		case domain.ErrCodeNotFound:
 			statusCode = http.StatusNotFound
+		case domain.ErrCodeUnauthorized:
+			statusCode = http.StatusUnauthorized
+		case domain.ErrCodeForbidden:
+			statusCode = http.StatusForbidden
 		case domain.ErrCodeTeamExists,
 			domain.ErrCodePRExists,
 			domain.ErrCodePRMerged,
 			domain.ErrCodeNotAssigned,
-			domain.ErrCodeNoCandidate:
+			domain.ErrCodeNoCandidate,
+			domain.ErrCodeMergeBlocked,
+			domain.ErrCodeInvalidTransition,
+			domain.ErrCodePRNotOpen,
+			domain.ErrCodeConcurrentUpdate,
+			domain.ErrCodeUserInAnotherTeam,
+			domain.ErrCodeUserHasOpenPRs,
+			domain.ErrCodeTeamArchived,
+			domain.ErrCodeTeamHasMembers,
+			domain.ErrCodeTeamHasOpenPRs,
+			domain.ErrCodeIdempotencyKeyReused,
+			domain.ErrCodeIdempotencyInFlight:
 			statusCode = http.StatusConflict
 		}
 
 		c.JSON(statusCode, ErrorResponse{
-			Error: struct {
-				Code    string `json:"code"`
-				Message string `json:"message"`
-			}{
+			Error: ErrorBody{
 				Code:    string(domainErr.Code),
 				Message: domainErr.Message,
+				Details: domainErr.Details,
 			},
 		})
 		return
@@ -70,16 +176,21 @@ func WriteJSONError(c *gin.Context, logger *slog.Logger, err error) {
 
 	logger.Error("internal server error", "error", err)
 	c.JSON(http.StatusInternalServerError, ErrorResponse{
-		Error: struct {
-			Code    string `json:"code"`
-			Message string `json:"message"`
-		}{
+		Error: ErrorBody{
 			Code:    "INTERNAL_ERROR",
 			Message: "internal server error",
 		},
 	})
 }
 
+func BindQuery(c *gin.Context, logger *slog.Logger, req interface{}) bool {
+	if err := c.ShouldBindQuery(req); err != nil {
+		WriteJSONError(c, logger, domain.NewError(domain.ErrCodeBadRequest, err.Error()))
+		return false
+	}
+	return true
+}
+
 func BindJSON(c *gin.Context, logger *slog.Logger, req interface{}) bool {
 	if err := c.ShouldBindJSON(req); err != nil {
 		WriteJSONError(c, logger, domain.NewError(domain.ErrCodeBadRequest, err.Error()))
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/service/auth"
+	"github.com/platonso/avito-pr-service/internal/transport/dto"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+type AuthHandler struct {
+	authService *auth.Service
+	logger      *slog.Logger
+}
+
+func NewAuthHandler(
+	authService *auth.Service,
+	logger *slog.Logger,
+) *AuthHandler {
+	return &AuthHandler{
+		authService: authService,
+		logger:      logger,
+	}
+}
+
+// Require authenticates request by X-API-Key header or bearer API key or JWT, checks that caller's role
+// allows the endpoint and puts the caller into request context
+func (h *AuthHandler) Require(role domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		caller, err := h.authService.Authenticate(c.Request.Context(), requestKey(c))
+		if err != nil {
+			dto.WriteJSONError(c, h.logger, err)
+			c.Abort()
+			return
+		}
+
+		if !caller.Role.Allows(role) {
+			h.logger.Warn("caller role is not allowed",
+				slog.String("key_id", caller.KeyID),
+				slog.String("user_id", caller.UserID),
+				slog.String("role", string(caller.Role)),
+				slog.String("required_role", string(role)),
+				slog.String("path", c.FullPath()))
+			dto.WriteJSONError(c, h.logger, domain.NewError(domain.ErrCodeForbidden,
+				"endpoint requires "+string(role)+" role"))
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(domain.WithCaller(c.Request.Context(), caller))
+		c.Next()
+	}
+}
+
+func requestKey(c *gin.Context) string {
+	if key := c.GetHeader("X-API-Key"); key != "" {
+		return key
+	}
+	scheme, token, ok := strings.Cut(c.GetHeader("Authorization"), " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	return ""
+}
+
+func (h *AuthHandler) CreateKey(c *gin.Context) {
+	var req dto.CreateAPIKeyReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	key, rawKey, err := h.authService.CreateKey(c.Request.Context(), req.Name, req.Role)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateAPIKeyResp{APIKey: key, Key: rawKey})
+}
+
+func (h *AuthHandler) ListKeys(c *gin.Context) {
+	keys, err := h.authService.ListKeys(c.Request.Context())
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ListAPIKeysResp{APIKeys: keys})
+}
+
+func (h *AuthHandler) RevokeKey(c *gin.Context) {
+	var req dto.RevokeAPIKeyReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	if err := h.authService.RevokeKey(c.Request.Context(), req.KeyID); err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
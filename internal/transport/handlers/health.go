@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/platonso/avito-pr-service/internal/transport/dto"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Upper bound for readiness checks, probes usually time out in a few seconds
+const readinessTimeout = 2 * time.Second
+
+// ReadinessCheck returns error when the service must not receive traffic
+type ReadinessCheck func(ctx context.Context) error
+
+type HealthHandler struct {
+	ready  ReadinessCheck
+	logger *slog.Logger
+}
+
+func NewHealthHandler(
+	ready ReadinessCheck,
+	logger *slog.Logger,
+) *HealthHandler {
+	return &HealthHandler{
+		ready:  ready,
+		logger: logger,
+	}
+}
+
+// Liveness only tells that the process serves HTTP
+func (h *HealthHandler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.HealthResp{Status: dto.HealthOK})
+}
+
+func (h *HealthHandler) Readiness(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), readinessTimeout)
+	defer cancel()
+
+	if err := h.ready(ctx); err != nil {
+		h.logger.Warn("service is not ready", slog.String("error", err.Error()))
+		c.JSON(http.StatusServiceUnavailable, dto.HealthResp{Status: dto.HealthNotReady, Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.HealthResp{Status: dto.HealthOK})
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/platonso/avito-pr-service/internal/domain"
+	"github.com/platonso/avito-pr-service/internal/service/idempotency"
+	"github.com/platonso/avito-pr-service/internal/transport/dto"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+const (
+	idempotencyKeyHeader = "Idempotency-Key"
+	// Set on responses replayed from storage
+	idempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+type IdempotencyHandler struct {
+	idempotencyService *idempotency.Service
+	logger             *slog.Logger
+}
+
+func NewIdempotencyHandler(
+	idempotencyService *idempotency.Service,
+	logger *slog.Logger,
+) *IdempotencyHandler {
+	return &IdempotencyHandler{
+		idempotencyService: idempotencyService,
+		logger:             logger,
+	}
+}
+
+// Middleware replays stored response for POST requests retried with the same Idempotency-Key and body.
+// It must run after authentication, keys are scoped by caller
+func (h *IdempotencyHandler) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(idempotencyKeyHeader)
+		if key == "" || c.Request.Method != http.MethodPost {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			dto.WriteJSONError(c, h.logger, domain.NewError(domain.ErrCodeBadRequest, "failed to read request body"))
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		scope := idempotencyScope(domain.CallerFromContext(ctx))
+		requestHash := idempotency.RequestHash(c.Request.Method, c.Request.URL.RequestURI(), body)
+
+		record, err := h.idempotencyService.Begin(ctx, scope, key, requestHash)
+		if err != nil {
+			dto.WriteJSONError(c, h.logger, err)
+			c.Abort()
+			return
+		}
+		if record.IsCompleted() {
+			c.Header(idempotentReplayedHeader, "true")
+			c.Data(record.StatusCode, record.ContentType, record.Body)
+			c.Abort()
+			return
+		}
+
+		// Outcome is stored even if the client has gone, its retry must see the same result
+		storeCtx := context.WithoutCancel(ctx)
+		completed := false
+		defer func() {
+			// Server errors and panics leave no stable outcome, the key is freed for retry
+			if !completed {
+				h.idempotencyService.Release(storeCtx, record)
+			}
+		}()
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		status := recorder.Status()
+		if status >= http.StatusInternalServerError {
+			return
+		}
+		record.StatusCode = status
+		record.ContentType = recorder.Header().Get("Content-Type")
+		record.Body = recorder.body.Bytes()
+		h.idempotencyService.Complete(storeCtx, record)
+		completed = true
+	}
+}
+
+func idempotencyScope(caller *domain.Caller) string {
+	switch {
+	case caller == nil:
+		return ""
+	case caller.UserID != "":
+		return "user:" + caller.UserID
+	default:
+		return "key:" + caller.KeyID
+	}
+}
+
+// Copy of the response body written by handlers
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
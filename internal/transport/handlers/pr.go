@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/platonso/avito-pr-service/internal/domain"
 	"github.com/platonso/avito-pr-service/internal/service/pr"
 	"github.com/platonso/avito-pr-service/internal/transport/dto"
 	"log/slog"
@@ -29,7 +30,13 @@ func (h *PRHandler) CreatePR(c *gin.Context) {
 		return
 	}
 
-	pullRequest, err := h.prService.CreatePullRequest(c.Request.Context(), req.PRID, req.PRName, req.AuthorID)
+	opts := domain.ReviewerOptions{
+		ReviewersCount:    req.ReviewersCount,
+		RequiredReviewers: req.RequiredReviewers,
+		ExcludedReviewers: req.ExcludedReviewers,
+		RequiredApprovals: req.RequiredApprovals,
+	}
+	pullRequest, err := h.prService.CreatePullRequest(c.Request.Context(), req.PRID, req.PRName, req.AuthorID, req.Draft, opts)
 	if err != nil {
 		dto.WriteJSONError(c, h.logger, err)
 		return
@@ -44,7 +51,61 @@ func (h *PRHandler) MergePR(c *gin.Context) {
 		return
 	}
 
-	pullRequest, err := h.prService.MergePR(c.Request.Context(), req.PRID)
+	opts := domain.MergeOptions{
+		Force:  req.Force,
+		Reason: req.Reason,
+	}
+	pullRequest, err := h.prService.MergePR(c.Request.Context(), req.PRID, opts)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PRResp{PR: pullRequest})
+}
+
+func (h *PRHandler) MarkReady(c *gin.Context) {
+	var req dto.ReadyPRReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	opts := domain.ReviewerOptions{
+		ReviewersCount:    req.ReviewersCount,
+		RequiredReviewers: req.RequiredReviewers,
+		ExcludedReviewers: req.ExcludedReviewers,
+	}
+	pullRequest, err := h.prService.MarkReady(c.Request.Context(), req.PRID, opts)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PRResp{PR: pullRequest})
+}
+
+func (h *PRHandler) ClosePR(c *gin.Context) {
+	var req dto.PRIDReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	pullRequest, err := h.prService.ClosePR(c.Request.Context(), req.PRID)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PRResp{PR: pullRequest})
+}
+
+func (h *PRHandler) ReopenPR(c *gin.Context) {
+	var req dto.PRIDReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	pullRequest, err := h.prService.ReopenPR(c.Request.Context(), req.PRID)
 	if err != nil {
 		dto.WriteJSONError(c, h.logger, err)
 		return
@@ -59,7 +120,12 @@ func (h *PRHandler) ReassignReviewer(c *gin.Context) {
 		return
 	}
 
-	pullRequest, newReviewerID, err := h.prService.ReassignReviewer(c.Request.Context(), req.PRID, req.OldReviewerID)
+	pullRequest, newReviewerID, err := h.prService.ReassignReviewer(
+		c.Request.Context(),
+		req.PRID,
+		req.OldReviewerID,
+		domain.Audit{ActorID: req.ActorID, Reason: req.Reason},
+	)
 	if err != nil {
 		dto.WriteJSONError(c, h.logger, err)
 		return
@@ -70,3 +136,95 @@ func (h *PRHandler) ReassignReviewer(c *gin.Context) {
 		ReplacedBy: newReviewerID,
 	})
 }
+
+func (h *PRHandler) SubmitReview(c *gin.Context) {
+	var req dto.ReviewPRReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	pullRequest, err := h.prService.SubmitReview(c.Request.Context(), req.PRID, req.ReviewerID, req.State, req.Comment)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PRResp{PR: pullRequest})
+}
+
+func (h *PRHandler) GetPR(c *gin.Context) {
+	prID := c.Query("pull_request_id")
+	if prID == "" {
+		err := domain.NewError(domain.ErrCodeBadRequest, "pull_request_id is required")
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	pullRequest, err := h.prService.GetPR(c.Request.Context(), prID)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PRResp{PR: pullRequest})
+}
+
+// GetPRs accepts repeated pull_request_id query parameters
+func (h *PRHandler) GetPRs(c *gin.Context) {
+	prs, notFound, err := h.prService.GetPRs(c.Request.Context(), c.QueryArray("pull_request_id"))
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PRBatchResp{
+		PullRequests: prs,
+		NotFound:     notFound,
+	})
+}
+
+func (h *PRHandler) GetHistory(c *gin.Context) {
+	prID := c.Query("pull_request_id")
+	if prID == "" {
+		err := domain.NewError(domain.ErrCodeBadRequest, "pull_request_id is required")
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	events, err := h.prService.GetHistory(c.Request.Context(), prID)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PRHistoryResp{
+		PRID:   prID,
+		Events: events,
+	})
+}
+
+func (h *PRHandler) ListPRs(c *gin.Context) {
+	var req dto.ListPRsReq
+	if !dto.BindQuery(c, h.logger, &req) {
+		return
+	}
+
+	prs, next, err := h.prService.ListPRs(c.Request.Context(), domain.PRFilter{
+		Status:      domain.PRStatus(req.Status),
+		AuthorID:    req.AuthorID,
+		ReviewerID:  req.ReviewerID,
+		TeamName:    req.TeamName,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		PageRequest: req.ToDomain(),
+	})
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ListPRsResp{
+		PullRequests: prs,
+		NextCursor:   next,
+	})
+}
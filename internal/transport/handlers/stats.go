@@ -24,7 +24,12 @@ func NewStatsHandler(
 }
 
 func (h *StatsHandler) GetReviewerStats(c *gin.Context) {
-	s, err := h.statsService.GetReviewerAssignmentsStats(c.Request.Context())
+	var req dto.StatsReq
+	if !dto.BindQuery(c, h.logger, &req) {
+		return
+	}
+
+	s, err := h.statsService.GetReviewerAssignmentsStats(c.Request.Context(), req.ToDomain())
 	if err != nil {
 		dto.WriteJSONError(c, h.logger, err)
 		return
@@ -34,7 +39,12 @@ func (h *StatsHandler) GetReviewerStats(c *gin.Context) {
 }
 
 func (h *StatsHandler) GetPRStats(c *gin.Context) {
-	s, err := h.statsService.GetPRStats(c.Request.Context())
+	var req dto.StatsReq
+	if !dto.BindQuery(c, h.logger, &req) {
+		return
+	}
+
+	s, err := h.statsService.GetPRStats(c.Request.Context(), req.ToDomain())
 	if err != nil {
 		dto.WriteJSONError(c, h.logger, err)
 		return
@@ -42,3 +52,33 @@ func (h *StatsHandler) GetPRStats(c *gin.Context) {
 
 	c.JSON(http.StatusOK, dto.PRStatsResp{Stats: s})
 }
+
+func (h *StatsHandler) GetLatencyStats(c *gin.Context) {
+	var req dto.StatsReq
+	if !dto.BindQuery(c, h.logger, &req) {
+		return
+	}
+
+	s, err := h.statsService.GetLatencyStats(c.Request.Context(), req.ToDomain())
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.LatencyStatsResp{Stats: s})
+}
+
+func (h *StatsHandler) GetFairness(c *gin.Context) {
+	var req dto.StatsReq
+	if !dto.BindQuery(c, h.logger, &req) {
+		return
+	}
+
+	teams, err := h.statsService.GetFairness(c.Request.Context(), req.ToDomain())
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FairnessResp{Teams: teams})
+}
@@ -25,7 +25,8 @@ func NewTeamHandler(
 }
 
 func (h *TeamHandler) CreateTeam(c *gin.Context) {
-	var t domain.Team
+	// Omitted settings fields keep their defaults
+	t := domain.Team{Settings: domain.DefaultTeamSettings()}
 	if !dto.BindJSON(c, h.logger, &t) {
 		return
 	}
@@ -55,3 +56,145 @@ func (h *TeamHandler) GetTeam(c *gin.Context) {
 
 	c.JSON(http.StatusOK, t)
 }
+
+func (h *TeamHandler) ListTeams(c *gin.Context) {
+	var req dto.ListTeamsReq
+	if !dto.BindQuery(c, h.logger, &req) {
+		return
+	}
+
+	teams, next, err := h.teamService.ListTeams(c.Request.Context(), domain.TeamFilter{
+		NamePrefix:  req.Name,
+		Archived:    req.Archived,
+		PageRequest: req.ToDomain(),
+	})
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ListTeamsResp{
+		Teams:      teams,
+		NextCursor: next,
+	})
+}
+
+func (h *TeamHandler) DeleteTeam(c *gin.Context) {
+	teamName := c.Query("team_name")
+	if teamName == "" {
+		dto.WriteJSONError(c, h.logger, domain.NewError(domain.ErrCodeBadRequest, "team_name is required"))
+		return
+	}
+	mode := domain.TeamDeleteMode(c.DefaultQuery("mode", string(domain.TeamDeleteArchive)))
+
+	t, err := h.teamService.DeleteTeam(c.Request.Context(), teamName, mode)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	resp := dto.DeleteTeamResp{
+		TeamName: teamName,
+		Mode:     mode,
+	}
+	if t != nil {
+		resp.ArchivedAt = t.ArchivedAt
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *TeamHandler) UpdateSettings(c *gin.Context) {
+	var req dto.UpdateTeamSettingsReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	settings, err := h.teamService.UpdateSettings(c.Request.Context(), req.TeamName, &req.TeamSettingsPatch)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TeamSettingsResp{
+		TeamName: req.TeamName,
+		Settings: settings,
+	})
+}
+
+func (h *TeamHandler) DeactivateUsers(c *gin.Context) {
+	var req dto.DeactivateUsersReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	report, err := h.teamService.DeactivateUsers(
+		c.Request.Context(),
+		req.TeamName,
+		req.UserIDs,
+		domain.Audit{ActorID: req.ActorID, Reason: req.Reason},
+	)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.DeactivateUsersResp{Report: report})
+}
+
+func (h *TeamHandler) AddMembers(c *gin.Context) {
+	var req dto.AddMembersReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	t, err := h.teamService.AddMembers(c.Request.Context(), req.TeamName, req.Members)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"team": t,
+	})
+}
+
+func (h *TeamHandler) RemoveMember(c *gin.Context) {
+	var req dto.RemoveMemberReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	report, err := h.teamService.RemoveMember(
+		c.Request.Context(),
+		req.TeamName,
+		req.UserID,
+		domain.Audit{ActorID: req.ActorID, Reason: req.Reason},
+	)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RemoveMemberResp{
+		TeamName:     req.TeamName,
+		UserID:       req.UserID,
+		Reassignment: report,
+	})
+}
+
+func (h *TeamHandler) MoveMember(c *gin.Context) {
+	var req dto.MoveMemberReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	t, err := h.teamService.MoveMember(c.Request.Context(), req.UserID, req.FromTeam, req.ToTeam)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"team": t,
+	})
+}
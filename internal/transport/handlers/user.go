@@ -30,13 +30,33 @@ func (h *UserHandler) SetIsActive(c *gin.Context) {
 		return
 	}
 
-	user, err := h.userService.SetUserIsActive(c.Request.Context(), req.UserID, *req.IsActive)
+	user, report, err := h.userService.SetUserIsActive(c.Request.Context(), req.UserID, *req.IsActive, req.ReassignReviews)
 	if err != nil {
 		dto.WriteJSONError(c, h.logger, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, dto.SetIsActiveResp{User: user})
+	c.JSON(http.StatusOK, dto.SetIsActiveResp{
+		User:         user,
+		Reassignment: report,
+	})
+}
+
+func (h *UserHandler) GetUser(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		err := domain.NewError(domain.ErrCodeBadRequest, "user_id is required")
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	profile, err := h.userService.GetUser(c.Request.Context(), userID)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UserProfileResp{User: profile})
 }
 
 func (h *UserHandler) GetReview(c *gin.Context) {
@@ -58,3 +78,100 @@ func (h *UserHandler) GetReview(c *gin.Context) {
 		PullRequests: prs,
 	})
 }
+
+func (h *UserHandler) CreateAbsence(c *gin.Context) {
+	var req dto.CreateAbsenceReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	absence := &domain.Absence{
+		UserID:   req.UserID,
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+		Reason:   req.Reason,
+	}
+	if err := h.userService.CreateAbsence(c.Request.Context(), absence); err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.AbsenceResp{Absence: absence})
+}
+
+func (h *UserHandler) GetAbsences(c *gin.Context) {
+	userID := c.Query("user_id")
+	if userID == "" {
+		err := domain.NewError(domain.ErrCodeBadRequest, "user_id is required")
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	absences, err := h.userService.GetAbsences(c.Request.Context(), userID)
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.AbsencesResp{
+		UserID:   userID,
+		Absences: absences,
+	})
+}
+
+func (h *UserHandler) UpdateAbsence(c *gin.Context) {
+	var req dto.UpdateAbsenceReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	absence := &domain.Absence{
+		ID:       req.AbsenceID,
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+		Reason:   req.Reason,
+	}
+	if err := h.userService.UpdateAbsence(c.Request.Context(), absence); err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.AbsenceResp{Absence: absence})
+}
+
+func (h *UserHandler) DeleteAbsence(c *gin.Context) {
+	var req dto.DeleteAbsenceReq
+	if !dto.BindJSON(c, h.logger, &req) {
+		return
+	}
+
+	if err := h.userService.DeleteAbsence(c.Request.Context(), req.AbsenceID); err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *UserHandler) ListUsers(c *gin.Context) {
+	var req dto.ListUsersReq
+	if !dto.BindQuery(c, h.logger, &req) {
+		return
+	}
+
+	users, next, err := h.userService.ListUsers(c.Request.Context(), domain.UserFilter{
+		TeamName:    req.TeamName,
+		IsActive:    req.IsActive,
+		Search:      req.Search,
+		PageRequest: req.ToDomain(),
+	})
+	if err != nil {
+		dto.WriteJSONError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ListUsersResp{
+		Users:      users,
+		NextCursor: next,
+	})
+}